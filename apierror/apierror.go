@@ -0,0 +1,127 @@
+package apierror
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// 응답 메시지 언어. 먼저 오는 것이 기본값이다.
+const (
+	Korean  = "ko"
+	English = "en"
+)
+
+var Languages = []string{Korean, English}
+
+// HTTPErrorCode 는 따로 정의하지 않은 fiber.Error 를 돌려줄 때 쓰는 코드다.
+const HTTPErrorCode = "HTTP_ERROR"
+
+// Error 는 API 에러 응답 하나다.
+// Code 는 클라이언트가 분기에 쓰는 고정된 값이고, 메시지는 Accept-Language 에 따라 고른다.
+type Error struct {
+	Status  int
+	Code    string
+	Details any
+
+	messages map[string]string
+	cause    error
+}
+
+// defined 는 codes.go 에 정의한 에러 목록이다. API 문서의 에러 코드 목록을 만들 때 쓴다.
+var defined []*Error
+
+func define(status int, code, ko, en string) *Error {
+	e := newError(status, code, ko, en)
+	defined = append(defined, e)
+	return e
+}
+
+func newError(status int, code, ko, en string) *Error {
+	return &Error{
+		Status:   status,
+		Code:     code,
+		messages: map[string]string{Korean: ko, English: en},
+	}
+}
+
+// All 은 정의된 모든 에러를 정의한 순서대로 돌려준다.
+func All() []*Error {
+	return append([]*Error(nil), defined...)
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Code + ": " + e.cause.Error()
+	}
+	return e.Code
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is 는 WithDetails, Wrap 으로 만든 복사본도 같은 코드면 같은 에러로 본다.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithDetails 는 details 를 붙인 복사본을 돌려준다.
+func (e *Error) WithDetails(details any) *Error {
+	c := *e
+	c.Details = details
+	return &c
+}
+
+// Wrap 은 원인을 붙인 복사본을 돌려준다. 원인은 로그에만 남고 응답에는 나가지 않는다.
+func (e *Error) Wrap(cause error) *Error {
+	c := *e
+	c.cause = cause
+	return &c
+}
+
+// Message 는 lang 으로 된 메시지를 돌려준다. 모르는 언어면 한국어다.
+func (e *Error) Message(lang string) string {
+	if m, ok := e.messages[lang]; ok {
+		return m
+	}
+	return e.messages[Korean]
+}
+
+// InvalidParam 은 쿼리나 경로 파라미터 name 이 잘못됐다는 에러다.
+func InvalidParam(name string) *Error {
+	return InvalidParameter.WithDetails(fiber.Map{"param": name})
+}
+
+// InvalidField 는 요청 본문의 필드 name 이 잘못됐다는 에러다.
+func InvalidField(name string) *Error {
+	return InvalidValue.WithDetails(fiber.Map{"field": name})
+}
+
+// From 은 핸들러가 돌려준 에러를 API 에러로 바꾼다.
+// fiber.Error(라우트 없음 등)는 상태 코드에 맞는 에러로, 그 밖의 에러는 Internal 로 바꾼다.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		switch fe.Code {
+		case fiber.StatusBadRequest:
+			return BadRequest
+		case fiber.StatusNotFound:
+			return RouteNotFound
+		case fiber.StatusMethodNotAllowed:
+			return MethodNotAllowed
+		case fiber.StatusRequestEntityTooLarge:
+			return PayloadTooLarge
+		case fiber.StatusServiceUnavailable:
+			return Unavailable
+		}
+		return newError(fe.Code, HTTPErrorCode, fe.Message, fe.Message)
+	}
+
+	return Internal.Wrap(err)
+}
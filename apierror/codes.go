@@ -0,0 +1,68 @@
+package apierror
+
+import "github.com/gofiber/fiber/v2"
+
+// 공통
+var (
+	BadRequest       = define(fiber.StatusBadRequest, "BAD_REQUEST", "잘못된 요청입니다.", "Bad request.")
+	InvalidBody      = define(fiber.StatusBadRequest, "INVALID_BODY", "요청 본문을 읽을 수 없습니다.", "The request body could not be parsed.")
+	InvalidParameter = define(fiber.StatusBadRequest, "INVALID_PARAMETER", "요청 파라미터가 올바르지 않습니다.", "A request parameter is invalid.")
+	InvalidValue     = define(fiber.StatusBadRequest, "INVALID_FIELD", "입력값이 올바르지 않습니다.", "A field in the request is invalid.")
+	Forbidden        = define(fiber.StatusForbidden, "FORBIDDEN", "권한이 없습니다.", "You do not have permission to do this.")
+	RouteNotFound    = define(fiber.StatusNotFound, "NOT_FOUND", "요청한 주소를 찾을 수 없습니다.", "The requested path does not exist.")
+	MethodNotAllowed = define(fiber.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "허용되지 않은 메서드입니다.", "Method not allowed.")
+	PayloadTooLarge  = define(fiber.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "요청이 너무 큽니다.", "The request is too large.")
+	Internal         = define(fiber.StatusInternalServerError, "INTERNAL", "서버 오류가 발생했습니다. 잠시 후 다시 시도해 주세요.", "Something went wrong. Please try again later.")
+	Unavailable      = define(fiber.StatusServiceUnavailable, "UNAVAILABLE", "서비스를 사용할 수 없습니다.", "The service is unavailable.")
+	WindowTooLarge   = define(fiber.StatusBadRequest, "WINDOW_TOO_LARGE", "조회 기간이 너무 깁니다.", "The time window is too long.")
+	ShuttingDown     = define(fiber.StatusServiceUnavailable, "SHUTTING_DOWN", "서버가 재시작 중입니다. 잠시 후 다시 시도해 주세요.", "The server is restarting. Please try again shortly.")
+)
+
+// 인증
+var (
+	MissingToken       = define(fiber.StatusUnauthorized, "MISSING_TOKEN", "로그인이 필요합니다.", "Authentication is required.")
+	InvalidToken       = define(fiber.StatusUnauthorized, "INVALID_TOKEN", "로그인이 만료되었습니다. 다시 로그인해 주세요.", "Your session has expired. Please log in again.")
+	SessionRevoked     = define(fiber.StatusUnauthorized, "SESSION_REVOKED", "로그인이 해제되었습니다. 다시 로그인해 주세요.", "Your session was revoked. Please log in again.")
+	InvalidCredentials = define(fiber.StatusUnauthorized, "INVALID_CREDENTIALS", "학번 또는 비밀번호가 올바르지 않습니다.", "Incorrect student number or password.")
+)
+
+// 찾을 수 없음
+var (
+	UserNotFound          = define(fiber.StatusNotFound, "USER_NOT_FOUND", "사용자를 찾을 수 없습니다.", "User not found.")
+	BoothNotFound         = define(fiber.StatusNotFound, "BOOTH_NOT_FOUND", "부스를 찾을 수 없습니다.", "Booth not found.")
+	ProductNotFound       = define(fiber.StatusNotFound, "PRODUCT_NOT_FOUND", "상품을 찾을 수 없습니다.", "Product not found.")
+	TransactionNotFound   = define(fiber.StatusNotFound, "TRANSACTION_NOT_FOUND", "거래를 찾을 수 없습니다.", "Transaction not found.")
+	ChargeRequestNotFound = define(fiber.StatusNotFound, "CHARGE_REQUEST_NOT_FOUND", "충전 요청을 찾을 수 없습니다.", "Charge request not found.")
+	RuleNotFound          = define(fiber.StatusNotFound, "RULE_NOT_FOUND", "자동 승인 규칙을 찾을 수 없습니다.", "Auto-approval rule not found.")
+	WebhookNotFound       = define(fiber.StatusNotFound, "WEBHOOK_NOT_FOUND", "웹훅을 찾을 수 없습니다.", "Webhook not found.")
+	DeliveryNotFound      = define(fiber.StatusNotFound, "DELIVERY_NOT_FOUND", "웹훅 전송 기록을 찾을 수 없습니다.", "Webhook delivery not found.")
+	SheetNotFound         = define(fiber.StatusNotFound, "SHEET_NOT_FOUND", "계정표가 없거나 이미 내려받았습니다.", "The credential sheet does not exist or was already downloaded.")
+)
+
+// 결제와 충전
+var (
+	InvalidPIN                  = define(fiber.StatusForbidden, "INVALID_PIN", "결제 PIN 이 올바르지 않습니다.", "Incorrect payment PIN.")
+	InsufficientBalance         = define(fiber.StatusBadRequest, "INSUFFICIENT_BALANCE", "잔액이 부족합니다.", "Not enough balance.")
+	AmountLimitExceeded         = define(fiber.StatusBadRequest, "AMOUNT_LIMIT_EXCEEDED", "한 번에 처리할 수 있는 금액을 넘었습니다.", "The amount exceeds the limit.")
+	QuantityLimitExceeded       = define(fiber.StatusBadRequest, "QUANTITY_LIMIT_EXCEEDED", "한 번에 살 수 있는 수량을 넘었습니다.", "The quantity exceeds the limit.")
+	ChargeRequestAlreadyDecided = define(fiber.StatusConflict, "CHARGE_REQUEST_ALREADY_DECIDED", "이미 처리된 충전 요청입니다.", "The charge request has already been decided.")
+	TransactionNotRefundable    = define(fiber.StatusConflict, "TRANSACTION_NOT_REFUNDABLE", "환불할 수 없는 거래입니다.", "The transaction cannot be refunded.")
+	RuleNeedsCondition          = define(fiber.StatusBadRequest, "RULE_NEEDS_CONDITION", "자동 승인 규칙에는 조건이 하나 이상 있어야 합니다.", "An auto-approval rule needs at least one condition.")
+)
+
+// 주문 처리
+var (
+	TransactionNotFulfillable = define(fiber.StatusConflict, "TRANSACTION_NOT_FULFILLABLE", "처리할 수 없는 주문입니다.", "The order cannot be fulfilled.")
+	FulfillmentBackward       = define(fiber.StatusConflict, "FULFILLMENT_BACKWARD", "주문 상태는 앞으로만 바꿀 수 있습니다.", "Fulfillment status can only move forward.")
+	OrderChanged              = define(fiber.StatusConflict, "ORDER_CHANGED", "주문이 바뀌었습니다. 새로고침 후 다시 시도해 주세요.", "The order changed. Reload and try again.")
+)
+
+// 계정, 부스, 웹훅
+var (
+	UsernameTaken   = define(fiber.StatusConflict, "USERNAME_TAKEN", "이미 있는 학번입니다.", "The student number is already registered.")
+	BoothConflict   = define(fiber.StatusConflict, "BOOTH_CONFLICT", "같은 이름의 부스가 있거나 이미 부스를 가진 사용자입니다.", "A booth with this name exists or the user already has a booth.")
+	MissingFile     = define(fiber.StatusBadRequest, "MISSING_FILE", "파일이 없습니다.", "No file was uploaded.")
+	InvalidFile     = define(fiber.StatusBadRequest, "INVALID_FILE", "파일을 읽을 수 없습니다.", "The file could not be read.")
+	InvalidRoster   = define(fiber.StatusUnprocessableEntity, "INVALID_ROSTER", "명단에 잘못된 줄이 있습니다.", "The roster has invalid rows.")
+	DeliveryNotDead = define(fiber.StatusConflict, "DELIVERY_NOT_DEAD", "실패로 끝난 전송만 다시 보낼 수 있습니다.", "Only dead deliveries can be retried.")
+)
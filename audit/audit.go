@@ -0,0 +1,325 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"somapay-backend/ent"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/hook"
+	"somapay-backend/ent/product"
+	"somapay-backend/ent/schema"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/ent/user"
+	"strings"
+	"unicode"
+)
+
+// 감사 대상 엔티티
+var audited = []string{
+	ent.TypeUser,
+	ent.TypeBooth,
+	ent.TypeProduct,
+	ent.TypeChargeRequest,
+	ent.TypeTransaction,
+}
+
+// 값 대신 바뀌었다는 사실만 남기는 필드. Sensitive 필드는 표시가 없어도 값을 가리고,
+// 여기 적은 필드는 스키마에서 Sensitive 를 빼더라도 값이 남지 않는다.
+var redacted = map[string]bool{
+	"password": true,
+	"pin":      true,
+}
+
+const redactedValue = "[REDACTED]"
+
+// 기록하지 않는 내부 카운터. 결제마다 올리는 부스 주문 번호를 남기면 구매자가 부스를 고친 것처럼 보인다.
+var ignored = map[string]map[string]bool{
+	ent.TypeBooth: {booth.FieldOrderSeq: true},
+}
+
+// hidden 은 Sensitive 라 json 태그가 "-" 인 필드의 값이다. 바뀌었는지 비교할 때만 쓰고 기록에는 남기지 않는다.
+type hidden struct {
+	value any
+}
+
+// 요청 컨텍스트에서 행위자와 IP 를 찾는 키. 핸들러가 넘기는 c.Context() 에서
+// fiber Locals 로 저장된 값을 그대로 읽을 수 있다.
+const (
+	userKey = "user"
+	ipKey   = "ip"
+)
+
+type mutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// Register 는 감사 대상 엔티티의 모든 변경을 같은 트랜잭션 안에서 AuditLog 로 남기는 훅을 건다.
+func Register(client *ent.Client) {
+	client.Use(hook.If(Hook(), func(_ context.Context, m ent.Mutation) bool {
+		return slices.Contains(audited, m.Type())
+	}))
+}
+
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			am, ok := m.(mutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				after := snapshot(v)
+				if err := record(ctx, am, "CREATE", after["id"].(int), diff(m.Type(), nil, after)); err != nil {
+					return nil, err
+				}
+				return v, nil
+
+			case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+				if onlyIgnored(m) {
+					return next.Mutate(ctx, m)
+				}
+				ids, err := am.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				before, err := load(ctx, am.Client(), m.Type(), ids)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				after, err := load(ctx, am.Client(), m.Type(), ids)
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
+					// 조건부 갱신에서 조건에 걸려 바뀌지 않은 행은 남기지 않는다
+					changes := diff(m.Type(), before[id], after[id])
+					if len(changes) == 0 {
+						continue
+					}
+					if err := record(ctx, am, "UPDATE", id, changes); err != nil {
+						return nil, err
+					}
+				}
+				return v, nil
+
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				ids, err := am.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				before, err := load(ctx, am.Client(), m.Type(), ids)
+				if err != nil {
+					return nil, err
+				}
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
+					if err := record(ctx, am, "DELETE", id, diff(m.Type(), before[id], nil)); err != nil {
+						return nil, err
+					}
+				}
+				return v, nil
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+func record(ctx context.Context, m mutation, action string, id int, changes map[string]schema.FieldChange) error {
+	q := m.Client().AuditLog.
+		Create().
+		SetEntityType(m.Type()).
+		SetEntityID(id).
+		SetAction(action).
+		SetChanges(changes)
+
+	if u, ok := ctx.Value(userKey).(*ent.User); ok && u != nil {
+		q.SetActorID(u.ID).SetActorUsername(u.Username)
+	}
+	if ip, ok := ctx.Value(ipKey).(string); ok {
+		q.SetIP(ip)
+	}
+
+	return q.Exec(ctx)
+}
+
+// load 는 ids 에 해당하는 행을 필드 이름별 값으로 읽어온다.
+func load(ctx context.Context, client *ent.Client, typ string, ids []int) (map[int]map[string]any, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	switch typ {
+	case ent.TypeUser:
+		return snapshots(client.User.Query().Where(user.IDIn(ids...)).All(ctx))
+	case ent.TypeBooth:
+		return snapshots(client.Booth.Query().Where(booth.IDIn(ids...)).All(ctx))
+	case ent.TypeProduct:
+		return snapshots(client.Product.Query().Where(product.IDIn(ids...)).All(ctx))
+	case ent.TypeChargeRequest:
+		return snapshots(client.ChargeRequest.Query().Where(chargerequest.IDIn(ids...)).All(ctx))
+	case ent.TypeTransaction:
+		return snapshots(client.Transaction.Query().Where(transaction.IDIn(ids...)).All(ctx))
+	}
+	return nil, fmt.Errorf("audit: unsupported type %s", typ)
+}
+
+func snapshots[T any](rows []T, err error) (map[int]map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[int]map[string]any, len(rows))
+	for _, r := range rows {
+		s := snapshot(r)
+		out[s["id"].(int)] = s
+	}
+	return out, nil
+}
+
+// snapshot 은 엔티티의 필드를 json 태그 이름으로 꺼낸다. 엣지는 뺀다.
+// Sensitive 필드(json:"-")는 스키마 필드 이름으로 바꿔 hidden 으로 감싼다.
+func snapshot(v any) map[string]any {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	rt := rv.Type()
+
+	out := make(map[string]any, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() || f.Name == "Edges" {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" {
+			continue
+		}
+		sensitive := name == "-"
+		if sensitive {
+			name = snakeCase(f.Name)
+		}
+
+		var value any
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if !fv.IsNil() {
+				value = fv.Elem().Interface()
+			}
+		} else {
+			value = fv.Interface()
+		}
+
+		if sensitive {
+			value = hidden{value}
+		}
+		out[name] = value
+	}
+	return out
+}
+
+// snakeCase 는 ent 가 만든 Go 필드 이름(SessionsRevokedAt, URL)을 스키마 필드 이름(sessions_revoked_at, url)으로 되돌린다.
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(runes[i-1])) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// onlyIgnored 는 m 이 기록하지 않는 필드만 바꾸는지 알려준다. 이때는 전후 값을 읽지 않는다.
+func onlyIgnored(m ent.Mutation) bool {
+	fields := append(append(m.Fields(), m.AddedFields()...), m.ClearedFields()...)
+	if len(fields) == 0 {
+		return false
+	}
+	for _, f := range fields {
+		if !ignored[m.Type()][f] {
+			return false
+		}
+	}
+	return true
+}
+
+// diff 는 값이 달라진 필드만 모은다. 비교는 JSON 으로 직렬화한 값으로 한다.
+func diff(typ string, before, after map[string]any) map[string]schema.FieldChange {
+	changes := make(map[string]schema.FieldChange)
+
+	keys := make(map[string]bool)
+	for k := range before {
+		keys[k] = true
+	}
+	for k := range after {
+		keys[k] = true
+	}
+	delete(keys, "id")
+	for k := range ignored[typ] {
+		delete(keys, k)
+	}
+
+	for k := range keys {
+		// 없는 필드와 NULL 은 같은 것으로 본다
+		old, oldHidden := unwrap(before[k])
+		cur, curHidden := unwrap(after[k])
+		if sameValue(old, cur) {
+			continue
+		}
+
+		c := schema.FieldChange{Old: old, New: cur}
+		if redacted[k] || oldHidden || curHidden {
+			c = schema.FieldChange{}
+			if old != nil {
+				c.Old = redactedValue
+			}
+			if cur != nil {
+				c.New = redactedValue
+			}
+		}
+		changes[k] = c
+	}
+	return changes
+}
+
+func unwrap(v any) (any, bool) {
+	if h, ok := v.(hidden); ok {
+		return h.value, true
+	}
+	return v, false
+}
+
+func sameValue(a, b any) bool {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ja) == string(jb)
+}
@@ -0,0 +1,114 @@
+package audit_test
+
+import (
+	entsql "entgo.io/ent/dialect/sql"
+	"path/filepath"
+	"somapay-backend/audit"
+	"somapay-backend/config"
+	"somapay-backend/ent"
+	"somapay-backend/ent/auditlog"
+	"somapay-backend/ent/enttest"
+	"somapay-backend/ent/schema"
+	"somapay-backend/storage"
+	"testing"
+)
+
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, dialectName, err := storage.OpenDB(config.DatabaseConfig{
+		Driver: "sqlite",
+		DSN:    filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialectName, db))))
+	t.Cleanup(func() { _ = client.Close() })
+	audit.Register(client)
+	return client
+}
+
+// updates 는 typ 엔티티 id 에 남은 UPDATE 기록을 오래된 순으로 돌려준다.
+func updates(t *testing.T, client *ent.Client, typ string, id int) []*ent.AuditLog {
+	t.Helper()
+
+	logs, err := client.AuditLog.
+		Query().
+		Where(auditlog.EntityTypeEQ(typ), auditlog.EntityIDEQ(id), auditlog.ActionEQ("UPDATE")).
+		Order(ent.Asc(auditlog.FieldID)).
+		All(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logs
+}
+
+func TestSensitiveChangesAreRedacted(t *testing.T) {
+	client := newTestClient(t)
+
+	u, err := client.User.Create().SetUsername("kim").SetPassword("hash1").SetPin("1234").SetPoint(0).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.AuditLog.Query().Where(auditlog.ActionEQ("CREATE"), auditlog.EntityIDEQ(u.ID)).Only(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := created.Changes["pin"]; got != (schema.FieldChange{New: "[REDACTED]"}) {
+		t.Errorf("create pin change = %+v", got)
+	}
+
+	if err := client.User.UpdateOne(u).SetPin("5678").Exec(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := updates(t, client, ent.TypeUser, u.ID)
+	if len(logs) != 1 {
+		t.Fatalf("update rows = %d, want 1", len(logs))
+	}
+	want := map[string]schema.FieldChange{"pin": {Old: "[REDACTED]", New: "[REDACTED]"}}
+	if got := logs[0].Changes; len(got) != 1 || got["pin"] != want["pin"] {
+		t.Errorf("changes = %+v, want %+v", got, want)
+	}
+
+	// 같은 값으로 바꾸면 남기지 않는다
+	if err := client.User.UpdateOne(u).SetPin("5678").Exec(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(updates(t, client, ent.TypeUser, u.ID)); n != 1 {
+		t.Errorf("update rows after no-op = %d, want 1", n)
+	}
+}
+
+func TestOrderSeqIsNotAudited(t *testing.T) {
+	client := newTestClient(t)
+
+	host, err := client.User.Create().SetUsername("host").SetPassword("x").SetPin("1234").SetPoint(0).SetRole("HOST").Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := client.Booth.Create().SetName("떡볶이").SetUser(host).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Booth.UpdateOne(b).AddOrderSeq(1).Exec(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(updates(t, client, ent.TypeBooth, b.ID)); n != 0 {
+		t.Errorf("update rows after order_seq bump = %d, want 0", n)
+	}
+
+	if err := client.Booth.UpdateOne(b).SetName("순대").AddOrderSeq(1).Exec(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	logs := updates(t, client, ent.TypeBooth, b.ID)
+	if len(logs) != 1 {
+		t.Fatalf("update rows = %d, want 1", len(logs))
+	}
+	if _, ok := logs[0].Changes["order_seq"]; ok || len(logs[0].Changes) != 1 {
+		t.Errorf("changes = %+v, want only name", logs[0].Changes)
+	}
+}
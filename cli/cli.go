@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"somapay-backend/ent"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// 관리 명령. 키는 "그룹 동작" 형식이다.
+var commands = map[string]struct {
+	usage string
+	run   func(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error
+}{
+	"user create":         {"user create <username> [-role USER] [-password pw] [-pin 1234]", userCreate},
+	"user set-role":       {"user set-role <username> <role>", userSetRole},
+	"user reset-password": {"user reset-password <username> [-password pw]", userResetPassword},
+	"user show":           {"user show <username>", userShow},
+	"booth create":        {"booth create <name> -host <username>", boothCreate},
+	"points grant":        {"points grant <username> <amount> -by <admin> [-reason PRIZE] [-note text]", pointsGrant},
+	"sessions revoke":     {"sessions revoke <username>", sessionsRevoke},
+}
+
+// IsCommand 는 args 가 관리 명령 그룹(user, booth, points, sessions)으로 시작하는지 본다.
+func IsCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for name := range commands {
+		if strings.HasPrefix(name, args[0]+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// Run 은 관리 명령 하나를 실행한다. args 는 "user create ..." 처럼 그룹 이름부터 시작한다.
+// 모든 명령은 -o table|json 으로 출력 형식을 고를 수 있다.
+func Run(ctx context.Context, client *ent.Client, args []string, w io.Writer) error {
+	if len(args) < 2 {
+		return errors.New(Usage())
+	}
+
+	name := args[0] + " " + args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		return errors.New(Usage())
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	format := fs.String("o", "table", "output format (table, json)")
+
+	err := cmd.run(ctx, client, fs, args[2:], &printer{w: w, format: format})
+	if errors.Is(err, errUsage) {
+		return errors.New("usage: somapay " + cmd.usage)
+	}
+	return err
+}
+
+func Usage() string {
+	lines := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		lines = append(lines, "  somapay "+cmd.usage)
+	}
+	sort.Strings(lines)
+	return "usage:\n" + strings.Join(lines, "\n") + "\n\n모든 명령은 -o table|json 으로 출력 형식을 고를 수 있습니다."
+}
+
+// ParseArgs 는 위치 인자 뒤에 오는 플래그도 읽는다 (예: down 0 -dry-run).
+func ParseArgs(fs *flag.FlagSet, args []string) error {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	return fs.Parse(positional)
+}
+
+var errUsage = errors.New("usage")
+
+// parse 는 플래그를 읽고 위치 인자가 n 개인지 확인한다.
+func parse(fs *flag.FlagSet, args []string, n int) error {
+	if err := ParseArgs(fs, args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() != n {
+		return errUsage
+	}
+	return nil
+}
+
+type printer struct {
+	w      io.Writer
+	format *string
+}
+
+// print 는 json 이면 v 를, table 이면 header 와 rows 를 출력한다.
+func (p *printer) print(v any, header []string, rows ...[]string) error {
+	switch *p.format {
+	case "json":
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+
+	case "table":
+		tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, r := range rows {
+			fmt.Fprintln(tw, strings.Join(r, "\t"))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown output format %q", *p.format)
+}
@@ -0,0 +1,340 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"somapay-backend/credential"
+	"somapay-backend/ent"
+	"somapay-backend/ent/user"
+	"somapay-backend/outbox"
+	"strconv"
+	"time"
+)
+
+var roles = map[string]bool{
+	"USER":  true,
+	"HOST":  true,
+	"ADMIN": true,
+}
+
+// 지급에 쓸 수 있는 조정 사유. 차감(PENALTY)은 API 로만 한다.
+var grantReasons = map[string]bool{
+	"CORRECTION":   true,
+	"COMPENSATION": true,
+	"PRIZE":        true,
+}
+
+type userView struct {
+	ID                int        `json:"id"`
+	Username          string     `json:"username"`
+	Role              string     `json:"role"`
+	Point             int64      `json:"point"`
+	Booth             string     `json:"booth,omitempty"`
+	SessionsRevokedAt *time.Time `json:"sessions_revoked_at,omitempty"`
+}
+
+// credentialView 는 새로 만든 비밀번호를 한 번만 보여준다.
+type credentialView struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Role     string `json:"role,omitempty"`
+	Password string `json:"password"`
+	Pin      string `json:"pin,omitempty"`
+}
+
+func findUser(ctx context.Context, client *ent.Client, username string) (*ent.User, error) {
+	u, err := client.User.Query().Where(user.UsernameEQ(username)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user %q not found", username)
+	}
+	return u, err
+}
+
+func userCreate(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	role := fs.String("role", "USER", "USER, HOST or ADMIN")
+	password := fs.String("password", "", "initial password (generated if empty)")
+	pin := fs.String("pin", "", "payment PIN (generated if empty)")
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+	if !roles[*role] {
+		return fmt.Errorf("invalid role %q", *role)
+	}
+
+	var err error
+	if *password == "" {
+		if *password, err = credential.RandomPassword(); err != nil {
+			return err
+		}
+	}
+	if *pin == "" {
+		if *pin, err = credential.RandomPIN(); err != nil {
+			return err
+		}
+	}
+
+	hashed, err := credential.HashPassword(*password)
+	if err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	u, err := tx.User.
+		Create().
+		SetUsername(fs.Arg(0)).
+		SetPassword(hashed).
+		SetPin(*pin).
+		SetRole(*role).
+		SetPoint(0).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("user %q already exists", fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	err = outbox.Record(ctx, tx, outbox.UserCreated, map[string]any{
+		"id":       u.ID,
+		"username": u.Username,
+		"role":     u.Role,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	v := credentialView{ID: u.ID, Username: u.Username, Role: u.Role, Password: *password, Pin: *pin}
+	return out.print(v,
+		[]string{"ID", "USERNAME", "ROLE", "PASSWORD", "PIN"},
+		[]string{strconv.Itoa(v.ID), v.Username, v.Role, v.Password, v.Pin},
+	)
+}
+
+func userSetRole(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	if err := parse(fs, args, 2); err != nil {
+		return err
+	}
+	role := fs.Arg(1)
+	if !roles[role] {
+		return fmt.Errorf("invalid role %q", role)
+	}
+
+	u, err := findUser(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := client.User.UpdateOne(u).SetRole(role).Exec(ctx); err != nil {
+		return err
+	}
+
+	return showUser(ctx, client, u.ID, out)
+}
+
+// userResetPassword 는 비밀번호를 바꾸고 기존 세션을 모두 끊는다.
+func userResetPassword(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	password := fs.String("password", "", "new password (generated if empty)")
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	u, err := findUser(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if *password == "" {
+		if *password, err = credential.RandomPassword(); err != nil {
+			return err
+		}
+	}
+	hashed, err := credential.HashPassword(*password)
+	if err != nil {
+		return err
+	}
+
+	err = client.User.
+		UpdateOne(u).
+		SetPassword(hashed).
+		SetSessionsRevokedAt(revocationTime()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	v := credentialView{ID: u.ID, Username: u.Username, Password: *password}
+	return out.print(v,
+		[]string{"ID", "USERNAME", "PASSWORD"},
+		[]string{strconv.Itoa(v.ID), v.Username, v.Password},
+	)
+}
+
+func userShow(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	u, err := findUser(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return showUser(ctx, client, u.ID, out)
+}
+
+func showUser(ctx context.Context, client *ent.Client, id int, out *printer) error {
+	u, err := client.User.Query().Where(user.IDEQ(id)).WithBooth().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	v := userView{
+		ID:                u.ID,
+		Username:          u.Username,
+		Role:              u.Role,
+		Point:             u.Point,
+		SessionsRevokedAt: u.SessionsRevokedAt,
+	}
+	if u.Edges.Booth != nil {
+		v.Booth = u.Edges.Booth.Name
+	}
+
+	revoked := "-"
+	if v.SessionsRevokedAt != nil {
+		revoked = v.SessionsRevokedAt.Local().Format(time.DateTime)
+	}
+	return out.print(v,
+		[]string{"ID", "USERNAME", "ROLE", "POINT", "BOOTH", "SESSIONS REVOKED AT"},
+		[]string{strconv.Itoa(v.ID), v.Username, v.Role, strconv.FormatInt(v.Point, 10), v.Booth, revoked},
+	)
+}
+
+func boothCreate(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	host := fs.String("host", "", "username of the booth host")
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+	if *host == "" {
+		return errors.New("-host is required")
+	}
+
+	u, err := findUser(ctx, client, *host)
+	if err != nil {
+		return err
+	}
+
+	b, err := client.Booth.
+		Create().
+		SetName(fs.Arg(0)).
+		SetUserID(u.ID).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("booth %q already exists or %q already has a booth", fs.Arg(0), u.Username)
+	}
+	if err != nil {
+		return err
+	}
+
+	return out.print(b,
+		[]string{"ID", "NAME", "HOST"},
+		[]string{strconv.Itoa(b.ID), b.Name, u.Username},
+	)
+}
+
+// pointsGrant 는 관리자 조정과 같은 방식으로 포인트를 지급한다. 조정 내역에 -by 관리자가 남는다.
+func pointsGrant(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	by := fs.String("by", "", "username of the admin issuing the grant")
+	reason := fs.String("reason", "PRIZE", "CORRECTION, COMPENSATION or PRIZE")
+	note := fs.String("note", "", "note shown in the adjustment history")
+	if err := parse(fs, args, 2); err != nil {
+		return err
+	}
+
+	amount, err := strconv.ParseInt(fs.Arg(1), 10, 64)
+	if err != nil || amount <= 0 {
+		return fmt.Errorf("invalid amount %q", fs.Arg(1))
+	}
+	if !grantReasons[*reason] {
+		return fmt.Errorf("invalid reason %q", *reason)
+	}
+	if *by == "" {
+		return errors.New("-by is required")
+	}
+
+	admin, err := findUser(ctx, client, *by)
+	if err != nil {
+		return err
+	}
+	if admin.Role != "ADMIN" {
+		return fmt.Errorf("%q is not an admin", admin.Username)
+	}
+	u, err := findUser(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	u, err = tx.User.UpdateOne(u).AddPoint(amount).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	a, err := tx.Adjustment.
+		Create().
+		SetAmount(amount).
+		SetReason(*reason).
+		SetNote(*note).
+		SetUserID(u.ID).
+		SetAdminID(admin.ID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return out.print(map[string]any{"adjustment": a, "point": u.Point},
+		[]string{"ADJUSTMENT", "USERNAME", "AMOUNT", "REASON", "POINT"},
+		[]string{strconv.Itoa(a.ID), u.Username, strconv.FormatInt(amount, 10), a.Reason, strconv.FormatInt(u.Point, 10)},
+	)
+}
+
+// sessionsRevoke 는 유저의 로그인 세션을 모두 끊는다.
+// 세션은 서버 메모리에 있으므로 끊은 시각을 DB 에 남기고, 서버가 그 이전에 발급된 세션을 거부한다.
+func sessionsRevoke(ctx context.Context, client *ent.Client, fs *flag.FlagSet, args []string, out *printer) error {
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	u, err := findUser(ctx, client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := client.User.UpdateOne(u).SetSessionsRevokedAt(revocationTime()).Exec(ctx); err != nil {
+		return err
+	}
+
+	return showUser(ctx, client, u.ID, out)
+}
+
+// revocationTime 은 초 단위로 올림한 현재 시각이다.
+// MySQL 은 시각을 초 단위로 저장하므로 내림하면 같은 초에 먼저 발급된 세션이 살아남는다.
+func revocationTime() time.Time {
+	return time.Now().Truncate(time.Second).Add(time.Second)
+}
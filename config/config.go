@@ -0,0 +1,350 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPath 는 -config 플래그나 SOMAPAY_CONFIG 가 없을 때 읽는 파일. 없으면 기본값만 쓴다.
+const DefaultPath = "config.json"
+
+type Config struct {
+	Listen         string         `json:"listen"`
+	AllowedOrigins []string       `json:"allowed_origins"`
+	Database       DatabaseConfig `json:"database"`
+	Session        SessionConfig  `json:"session"`
+	Payment        PaymentConfig  `json:"payment"`
+	Log            LogConfig      `json:"log"`
+	Metrics        MetricsConfig  `json:"metrics"`
+	// 종료 신호를 받은 뒤 처리 중인 요청을 기다리는 최대 시간
+	ShutdownTimeout Duration `json:"shutdown_timeout"`
+}
+
+var drivers = map[string]bool{
+	"mysql":    true,
+	"postgres": true,
+	"sqlite":   true,
+}
+
+// DatabaseConfig 의 Driver 는 mysql, postgres, sqlite 중 하나다.
+// sqlite 의 DSN 은 파일 경로(예: somapay.db)이고, postgres 는 URL 이나 key=value 형식을 쓴다.
+type DatabaseConfig struct {
+	Driver string `json:"driver"`
+	DSN    string `json:"dsn"`
+}
+
+// SessionConfig 의 시간이 0 이면 제한하지 않는다.
+type SessionConfig struct {
+	IdleTimeout Duration `json:"idle_timeout"`
+	MaxLifetime Duration `json:"max_lifetime"`
+}
+
+// PaymentConfig 의 한도가 0 이면 제한하지 않는다.
+type PaymentConfig struct {
+	MaxChargeAmount      int64 `json:"max_charge_amount"`
+	MaxTransactionAmount int64 `json:"max_transaction_amount"`
+	MaxQuantity          int   `json:"max_quantity"`
+}
+
+var logFormats = map[string]bool{
+	"text": true,
+	"json": true,
+}
+
+// LogConfig 의 Level 은 debug, info, warn, error 중 하나이고 Format 은 text 나 json 이다.
+type LogConfig struct {
+	Level  string `json:"level"`
+	Format string `json:"format"`
+}
+
+// SlogLevel 은 Level 을 slog 레벨로 바꾼다. 잘못된 값이면 info 다.
+func (c LogConfig) SlogLevel() slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// MetricsConfig 의 AllowedNetworks 에서 온 요청은 로그인 없이 /metrics 를 볼 수 있다.
+// CIDR(10.0.0.0/8) 이나 IP 하나를 쓴다. 비어 있으면 관리자만 볼 수 있다.
+// 연결한 주소(c.IP())로 판단하므로 같은 서버의 리버스 프록시를 거친 요청은 모두 루프백에서 온 것으로 보인다.
+type MetricsConfig struct {
+	AllowedNetworks []string `json:"allowed_networks"`
+}
+
+func (c MetricsConfig) Networks() ([]netip.Prefix, error) {
+	networks := make([]netip.Prefix, 0, len(c.AllowedNetworks))
+	for _, s := range c.AllowedNetworks {
+		if p, err := netip.ParsePrefix(s); err == nil {
+			networks = append(networks, p.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q", s)
+		}
+		networks = append(networks, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return networks, nil
+}
+
+// Duration 은 설정 파일에서 "30m" 같은 문자열로 쓰는 시간 값이다.
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// Default 는 설정 파일과 환경 변수가 없을 때의 값이다.
+// DSN 은 비워 두어 반드시 지정하게 하고, 세션 시간과 결제 한도는 0(제한 없음)으로 둔다.
+// 운영에 쓸 한도는 config.example.json 을 참고한다.
+func Default() *Config {
+	return &Config{
+		Listen:         "0.0.0.0:8443",
+		AllowedOrigins: []string{"https://pay.gbsw.hs.kr"},
+		Database: DatabaseConfig{
+			Driver: "mysql",
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		ShutdownTimeout: Duration{15 * time.Second},
+	}
+}
+
+// Load 는 기본값 위에 path 의 JSON 파일과 SOMAPAY_* 환경 변수를 차례로 덮어쓴 뒤 검증한다.
+// path 가 DefaultPath 이고 파일이 없으면 파일 없이 진행한다.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	b, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(b, cfg); err != nil {
+			return nil, fmt.Errorf("config: parse %s: %w", path, err)
+		}
+	case errors.Is(err, os.ErrNotExist) && path == DefaultPath:
+	default:
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) applyEnv() error {
+	if v, ok := os.LookupEnv("SOMAPAY_LISTEN"); ok {
+		cfg.Listen = v
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_ALLOWED_ORIGINS"); ok {
+		cfg.AllowedOrigins = splitList(v)
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_DB_DRIVER"); ok {
+		cfg.Database.Driver = v
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_DB_DSN"); ok {
+		cfg.Database.DSN = v
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_METRICS_ALLOWED_NETWORKS"); ok {
+		cfg.Metrics.AllowedNetworks = splitList(v)
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_LOG_LEVEL"); ok {
+		cfg.Log.Level = v
+	}
+	if v, ok := os.LookupEnv("SOMAPAY_LOG_FORMAT"); ok {
+		cfg.Log.Format = v
+	}
+
+	durations := []struct {
+		key string
+		dst *Duration
+	}{
+		{"SOMAPAY_SESSION_IDLE_TIMEOUT", &cfg.Session.IdleTimeout},
+		{"SOMAPAY_SESSION_MAX_LIFETIME", &cfg.Session.MaxLifetime},
+		{"SOMAPAY_SHUTDOWN_TIMEOUT", &cfg.ShutdownTimeout},
+	}
+	for _, d := range durations {
+		v, ok := os.LookupEnv(d.key)
+		if !ok {
+			continue
+		}
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("config: invalid %s: %w", d.key, err)
+		}
+		d.dst.Duration = parsed
+	}
+
+	amounts := []struct {
+		key string
+		dst *int64
+	}{
+		{"SOMAPAY_MAX_CHARGE_AMOUNT", &cfg.Payment.MaxChargeAmount},
+		{"SOMAPAY_MAX_TRANSACTION_AMOUNT", &cfg.Payment.MaxTransactionAmount},
+	}
+	for _, a := range amounts {
+		v, ok := os.LookupEnv(a.key)
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("config: invalid %s: %w", a.key, err)
+		}
+		*a.dst = parsed
+	}
+
+	if v, ok := os.LookupEnv("SOMAPAY_MAX_QUANTITY"); ok {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("config: invalid SOMAPAY_MAX_QUANTITY: %w", err)
+		}
+		cfg.Payment.MaxQuantity = parsed
+	}
+
+	return nil
+}
+
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Validate 는 잘못된 설정을 모두 모아 하나의 에러로 돌려준다.
+func (cfg *Config) Validate() error {
+	var errs []error
+
+	if cfg.Listen == "" {
+		errs = append(errs, errors.New("listen is required"))
+	}
+	if len(cfg.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("allowed_origins is required"))
+	}
+	for _, o := range cfg.AllowedOrigins {
+		if o == "*" {
+			continue
+		}
+		u, err := url.Parse(o)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid allowed origin %q", o))
+		}
+	}
+
+	if !drivers[cfg.Database.Driver] {
+		errs = append(errs, fmt.Errorf("unsupported database driver %q", cfg.Database.Driver))
+	}
+	if cfg.Database.DSN == "" {
+		errs = append(errs, errors.New("database.dsn is required"))
+	}
+
+	if cfg.Session.IdleTimeout.Duration < 0 || cfg.Session.MaxLifetime.Duration < 0 {
+		errs = append(errs, errors.New("session timeouts must not be negative"))
+	}
+
+	if cfg.Payment.MaxChargeAmount < 0 || cfg.Payment.MaxTransactionAmount < 0 || cfg.Payment.MaxQuantity < 0 {
+		errs = append(errs, errors.New("payment limits must not be negative"))
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("invalid log level %q", cfg.Log.Level))
+	}
+	if !logFormats[cfg.Log.Format] {
+		errs = append(errs, fmt.Errorf("unsupported log format %q", cfg.Log.Format))
+	}
+
+	if _, err := cfg.Metrics.Networks(); err != nil {
+		errs = append(errs, fmt.Errorf("metrics.allowed_networks: %w", err))
+	}
+
+	if cfg.ShutdownTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout must be positive"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// String 은 로그에 남겨도 되도록 DSN 의 비밀번호를 가린 설정을 돌려준다.
+func (cfg *Config) String() string {
+	c := *cfg
+	c.Database.DSN = RedactDSN(cfg.Database.DSN)
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "config: " + err.Error()
+	}
+	return string(b)
+}
+
+// key=value 형식의 password 항목 (PostgreSQL)
+var dsnPasswordKey = regexp.MustCompile(`(password=)('[^']*'|\S*)`)
+
+// url.URL.Redacted 와 같은 표시를 쓴다
+const redacted = "xxxxx"
+
+func RedactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" && u.User != nil {
+		return u.Redacted()
+	}
+
+	dsn = dsnPasswordKey.ReplaceAllString(dsn, "${1}"+redacted)
+	return redactUserPassword(dsn)
+}
+
+// redactUserPassword 는 MySQL 의 user:password@tcp(...)/db 형식에서 비밀번호를 가린다.
+// 비밀번호에 @ 나 / 가 들어갈 수 있으므로 드라이버처럼 마지막 / 앞의 마지막 @ 에서 나눈다.
+func redactUserPassword(dsn string) string {
+	slash := strings.LastIndex(dsn, "/")
+	if slash < 0 {
+		return dsn
+	}
+	at := strings.LastIndex(dsn[:slash], "@")
+	if at < 0 {
+		return dsn
+	}
+	user, _, ok := strings.Cut(dsn[:at], ":")
+	if !ok || strings.ContainsAny(user, "@/= ") {
+		return dsn
+	}
+	return user + ":" + redacted + dsn[at:]
+}
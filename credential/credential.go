@@ -0,0 +1,52 @@
+package credential
+
+import (
+	"crypto/rand"
+	"golang.org/x/crypto/bcrypt"
+	"math/big"
+)
+
+// 헷갈리기 쉬운 문자(0/O, 1/l/I)는 뺀다. 인쇄해서 나눠 주는 초기 비밀번호에 쓴다.
+const passwordChars = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const (
+	PasswordLength = 10
+	PINLength      = 4
+)
+
+// generatedCost 는 RandomPassword 로 만든 비밀번호에 쓰는 bcrypt 비용이다.
+// 임의로 만든 10자리(약 58비트)는 비용을 낮춰도 대입 공격으로 찾을 수 없고,
+// 명단 수백 명을 한 요청에서 해시할 수 있을 만큼 빨라진다.
+const generatedCost = 6
+
+func HashPassword(password string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(b), err
+}
+
+// HashGeneratedPassword 는 RandomPassword 로 만든 비밀번호만 해시한다. 사용자가 정한 비밀번호에는 HashPassword 를 쓴다.
+func HashGeneratedPassword(password string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(password), generatedCost)
+	return string(b), err
+}
+
+func RandomPassword() (string, error) {
+	return randomString(passwordChars, PasswordLength)
+}
+
+func RandomPIN() (string, error) {
+	return randomString("0123456789", PINLength)
+}
+
+func randomString(charset string, n int) (string, error) {
+	b := make([]byte, n)
+	max := big.NewInt(int64(len(charset)))
+	for i := range b {
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = charset[idx.Int64()]
+	}
+	return string(b), nil
+}
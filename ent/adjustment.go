@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Adjustment is the model entity for the Adjustment schema.
+type Adjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AdjustmentQuery when eager-loading is set.
+	Edges                   AdjustmentEdges `json:"edges"`
+	user_adjustments        *int
+	user_issued_adjustments *int
+	selectValues            sql.SelectValues
+}
+
+// AdjustmentEdges holds the relations/edges for other nodes in the graph.
+type AdjustmentEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Admin holds the value of the admin edge.
+	Admin *User `json:"admin,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdjustmentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AdminOrErr returns the Admin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdjustmentEdges) AdminOrErr() (*User, error) {
+	if e.Admin != nil {
+		return e.Admin, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "admin"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Adjustment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adjustment.FieldID, adjustment.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case adjustment.FieldReason, adjustment.FieldNote:
+			values[i] = new(sql.NullString)
+		case adjustment.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		case adjustment.ForeignKeys[0]: // user_adjustments
+			values[i] = new(sql.NullInt64)
+		case adjustment.ForeignKeys[1]: // user_issued_adjustments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Adjustment fields.
+func (_m *Adjustment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adjustment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adjustment.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case adjustment.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case adjustment.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case adjustment.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		case adjustment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_adjustments", value)
+			} else if value.Valid {
+				_m.user_adjustments = new(int)
+				*_m.user_adjustments = int(value.Int64)
+			}
+		case adjustment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_issued_adjustments", value)
+			} else if value.Valid {
+				_m.user_issued_adjustments = new(int)
+				*_m.user_issued_adjustments = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Adjustment.
+// This includes values selected through modifiers, order, etc.
+func (_m *Adjustment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Adjustment entity.
+func (_m *Adjustment) QueryUser() *UserQuery {
+	return NewAdjustmentClient(_m.config).QueryUser(_m)
+}
+
+// QueryAdmin queries the "admin" edge of the Adjustment entity.
+func (_m *Adjustment) QueryAdmin() *UserQuery {
+	return NewAdjustmentClient(_m.config).QueryAdmin(_m)
+}
+
+// Update returns a builder for updating this Adjustment.
+// Note that you need to call Adjustment.Unwrap() before calling this method if this Adjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Adjustment) Update() *AdjustmentUpdateOne {
+	return NewAdjustmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Adjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Adjustment) Unwrap() *Adjustment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Adjustment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Adjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Adjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Adjustments is a parsable slice of Adjustment.
+type Adjustments []*Adjustment
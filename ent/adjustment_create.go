@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdjustmentCreate is the builder for creating a Adjustment entity.
+type AdjustmentCreate struct {
+	config
+	mutation *AdjustmentMutation
+	hooks    []Hook
+}
+
+// SetAmount sets the "amount" field.
+func (_c *AdjustmentCreate) SetAmount(v int64) *AdjustmentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AdjustmentCreate) SetReason(v string) *AdjustmentCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *AdjustmentCreate) SetNote(v string) *AdjustmentCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *AdjustmentCreate) SetNillableNote(v *string) *AdjustmentCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *AdjustmentCreate) SetTimestamp(v time.Time) *AdjustmentCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_c *AdjustmentCreate) SetNillableTimestamp(v *time.Time) *AdjustmentCreate {
+	if v != nil {
+		_c.SetTimestamp(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *AdjustmentCreate) SetUserID(id int) *AdjustmentCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AdjustmentCreate) SetUser(v *User) *AdjustmentCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAdminID sets the "admin" edge to the User entity by ID.
+func (_c *AdjustmentCreate) SetAdminID(id int) *AdjustmentCreate {
+	_c.mutation.SetAdminID(id)
+	return _c
+}
+
+// SetAdmin sets the "admin" edge to the User entity.
+func (_c *AdjustmentCreate) SetAdmin(v *User) *AdjustmentCreate {
+	return _c.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdjustmentMutation object of the builder.
+func (_c *AdjustmentCreate) Mutation() *AdjustmentMutation {
+	return _c.mutation
+}
+
+// Save creates the Adjustment in the database.
+func (_c *AdjustmentCreate) Save(ctx context.Context) (*Adjustment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdjustmentCreate) SaveX(ctx context.Context) *Adjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdjustmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdjustmentCreate) defaults() {
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		v := adjustment.DefaultTimestamp()
+		_c.mutation.SetTimestamp(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdjustmentCreate) check() error {
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Adjustment.amount"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Adjustment.reason"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Adjustment.timestamp"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Adjustment.user"`)}
+	}
+	if len(_c.mutation.AdminIDs()) == 0 {
+		return &ValidationError{Name: "admin", err: errors.New(`ent: missing required edge "Adjustment.admin"`)}
+	}
+	return nil
+}
+
+func (_c *AdjustmentCreate) sqlSave(ctx context.Context) (*Adjustment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdjustmentCreate) createSpec() (*Adjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Adjustment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adjustment.Table, sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(adjustment.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(adjustment.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(adjustment.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(adjustment.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.UserTable,
+			Columns: []string{adjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_adjustments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.AdminTable,
+			Columns: []string{adjustment.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_issued_adjustments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AdjustmentCreateBulk is the builder for creating many Adjustment entities in bulk.
+type AdjustmentCreateBulk struct {
+	config
+	err      error
+	builders []*AdjustmentCreate
+}
+
+// Save creates the Adjustment entities in the database.
+func (_c *AdjustmentCreateBulk) Save(ctx context.Context) ([]*Adjustment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Adjustment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdjustmentCreateBulk) SaveX(ctx context.Context) []*Adjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/predicate"
+	"somapay-backend/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdjustmentUpdate is the builder for updating Adjustment entities.
+type AdjustmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AdjustmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AdjustmentUpdate builder.
+func (_u *AdjustmentUpdate) Where(ps ...predicate.Adjustment) *AdjustmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *AdjustmentUpdate) SetAmount(v int64) *AdjustmentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *AdjustmentUpdate) SetNillableAmount(v *int64) *AdjustmentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *AdjustmentUpdate) AddAmount(v int64) *AdjustmentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AdjustmentUpdate) SetReason(v string) *AdjustmentUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AdjustmentUpdate) SetNillableReason(v *string) *AdjustmentUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *AdjustmentUpdate) SetNote(v string) *AdjustmentUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *AdjustmentUpdate) SetNillableNote(v *string) *AdjustmentUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *AdjustmentUpdate) ClearNote() *AdjustmentUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *AdjustmentUpdate) SetTimestamp(v time.Time) *AdjustmentUpdate {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *AdjustmentUpdate) SetNillableTimestamp(v *time.Time) *AdjustmentUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *AdjustmentUpdate) SetUserID(id int) *AdjustmentUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AdjustmentUpdate) SetUser(v *User) *AdjustmentUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAdminID sets the "admin" edge to the User entity by ID.
+func (_u *AdjustmentUpdate) SetAdminID(id int) *AdjustmentUpdate {
+	_u.mutation.SetAdminID(id)
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the User entity.
+func (_u *AdjustmentUpdate) SetAdmin(v *User) *AdjustmentUpdate {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdjustmentMutation object of the builder.
+func (_u *AdjustmentUpdate) Mutation() *AdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AdjustmentUpdate) ClearUser() *AdjustmentUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAdmin clears the "admin" edge to the User entity.
+func (_u *AdjustmentUpdate) ClearAdmin() *AdjustmentUpdate {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdjustmentUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Adjustment.user"`)
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Adjustment.admin"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AdjustmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AdjustmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AdjustmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adjustment.Table, adjustment.Columns, sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(adjustment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(adjustment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(adjustment.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(adjustment.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(adjustment.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(adjustment.FieldTimestamp, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.UserTable,
+			Columns: []string{adjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.UserTable,
+			Columns: []string{adjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.AdminTable,
+			Columns: []string{adjustment.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.AdminTable,
+			Columns: []string{adjustment.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdjustmentUpdateOne is the builder for updating a single Adjustment entity.
+type AdjustmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AdjustmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAmount sets the "amount" field.
+func (_u *AdjustmentUpdateOne) SetAmount(v int64) *AdjustmentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *AdjustmentUpdateOne) SetNillableAmount(v *int64) *AdjustmentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *AdjustmentUpdateOne) AddAmount(v int64) *AdjustmentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AdjustmentUpdateOne) SetReason(v string) *AdjustmentUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AdjustmentUpdateOne) SetNillableReason(v *string) *AdjustmentUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *AdjustmentUpdateOne) SetNote(v string) *AdjustmentUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *AdjustmentUpdateOne) SetNillableNote(v *string) *AdjustmentUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *AdjustmentUpdateOne) ClearNote() *AdjustmentUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *AdjustmentUpdateOne) SetTimestamp(v time.Time) *AdjustmentUpdateOne {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *AdjustmentUpdateOne) SetNillableTimestamp(v *time.Time) *AdjustmentUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *AdjustmentUpdateOne) SetUserID(id int) *AdjustmentUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AdjustmentUpdateOne) SetUser(v *User) *AdjustmentUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAdminID sets the "admin" edge to the User entity by ID.
+func (_u *AdjustmentUpdateOne) SetAdminID(id int) *AdjustmentUpdateOne {
+	_u.mutation.SetAdminID(id)
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the User entity.
+func (_u *AdjustmentUpdateOne) SetAdmin(v *User) *AdjustmentUpdateOne {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdjustmentMutation object of the builder.
+func (_u *AdjustmentUpdateOne) Mutation() *AdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AdjustmentUpdateOne) ClearUser() *AdjustmentUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAdmin clears the "admin" edge to the User entity.
+func (_u *AdjustmentUpdateOne) ClearAdmin() *AdjustmentUpdateOne {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Where appends a list predicates to the AdjustmentUpdate builder.
+func (_u *AdjustmentUpdateOne) Where(ps ...predicate.Adjustment) *AdjustmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdjustmentUpdateOne) Select(field string, fields ...string) *AdjustmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Adjustment entity.
+func (_u *AdjustmentUpdateOne) Save(ctx context.Context) (*Adjustment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdjustmentUpdateOne) SaveX(ctx context.Context) *Adjustment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdjustmentUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Adjustment.user"`)
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Adjustment.admin"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AdjustmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AdjustmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *Adjustment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adjustment.Table, adjustment.Columns, sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Adjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adjustment.FieldID)
+		for _, f := range fields {
+			if !adjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(adjustment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(adjustment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(adjustment.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(adjustment.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(adjustment.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(adjustment.FieldTimestamp, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.UserTable,
+			Columns: []string{adjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.UserTable,
+			Columns: []string{adjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.AdminTable,
+			Columns: []string{adjustment.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adjustment.AdminTable,
+			Columns: []string{adjustment.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Adjustment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AutoApprovalRule is the model entity for the AutoApprovalRule schema.
+type AutoApprovalRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MaxAmount holds the value of the "max_amount" field.
+	MaxAmount *int64 `json:"max_amount,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// MaxDailyApprovals holds the value of the "max_daily_approvals" field.
+	MaxDailyApprovals *int `json:"max_daily_approvals,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AutoApprovalRuleQuery when eager-loading is set.
+	Edges        AutoApprovalRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AutoApprovalRuleEdges holds the relations/edges for other nodes in the graph.
+type AutoApprovalRuleEdges struct {
+	// ChargeRequests holds the value of the charge_requests edge.
+	ChargeRequests []*ChargeRequest `json:"charge_requests,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ChargeRequestsOrErr returns the ChargeRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e AutoApprovalRuleEdges) ChargeRequestsOrErr() ([]*ChargeRequest, error) {
+	if e.loadedTypes[0] {
+		return e.ChargeRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "charge_requests"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutoApprovalRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case autoapprovalrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case autoapprovalrule.FieldID, autoapprovalrule.FieldMaxAmount, autoapprovalrule.FieldMaxDailyApprovals:
+			values[i] = new(sql.NullInt64)
+		case autoapprovalrule.FieldName, autoapprovalrule.FieldRole:
+			values[i] = new(sql.NullString)
+		case autoapprovalrule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutoApprovalRule fields.
+func (_m *AutoApprovalRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case autoapprovalrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case autoapprovalrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case autoapprovalrule.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				_m.MaxAmount = new(int64)
+				*_m.MaxAmount = value.Int64
+			}
+		case autoapprovalrule.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case autoapprovalrule.FieldMaxDailyApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_daily_approvals", values[i])
+			} else if value.Valid {
+				_m.MaxDailyApprovals = new(int)
+				*_m.MaxDailyApprovals = int(value.Int64)
+			}
+		case autoapprovalrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case autoapprovalrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AutoApprovalRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *AutoApprovalRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryChargeRequests queries the "charge_requests" edge of the AutoApprovalRule entity.
+func (_m *AutoApprovalRule) QueryChargeRequests() *ChargeRequestQuery {
+	return NewAutoApprovalRuleClient(_m.config).QueryChargeRequests(_m)
+}
+
+// Update returns a builder for updating this AutoApprovalRule.
+// Note that you need to call AutoApprovalRule.Unwrap() before calling this method if this AutoApprovalRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AutoApprovalRule) Update() *AutoApprovalRuleUpdateOne {
+	return NewAutoApprovalRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AutoApprovalRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AutoApprovalRule) Unwrap() *AutoApprovalRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutoApprovalRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AutoApprovalRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutoApprovalRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.MaxAmount; v != nil {
+		builder.WriteString("max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	if v := _m.MaxDailyApprovals; v != nil {
+		builder.WriteString("max_daily_approvals=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AutoApprovalRules is a parsable slice of AutoApprovalRule.
+type AutoApprovalRules []*AutoApprovalRule
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package autoapprovalrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the autoapprovalrule type in the database.
+	Label = "auto_approval_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMaxAmount holds the string denoting the max_amount field in the database.
+	FieldMaxAmount = "max_amount"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldMaxDailyApprovals holds the string denoting the max_daily_approvals field in the database.
+	FieldMaxDailyApprovals = "max_daily_approvals"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeChargeRequests holds the string denoting the charge_requests edge name in mutations.
+	EdgeChargeRequests = "charge_requests"
+	// Table holds the table name of the autoapprovalrule in the database.
+	Table = "auto_approval_rules"
+	// ChargeRequestsTable is the table that holds the charge_requests relation/edge.
+	ChargeRequestsTable = "charge_requests"
+	// ChargeRequestsInverseTable is the table name for the ChargeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "chargerequest" package.
+	ChargeRequestsInverseTable = "charge_requests"
+	// ChargeRequestsColumn is the table column denoting the charge_requests relation/edge.
+	ChargeRequestsColumn = "auto_approval_rule_charge_requests"
+)
+
+// Columns holds all SQL columns for autoapprovalrule fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldMaxAmount,
+	FieldRole,
+	FieldMaxDailyApprovals,
+	FieldEnabled,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AutoApprovalRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMaxAmount orders the results by the max_amount field.
+func ByMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAmount, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByMaxDailyApprovals orders the results by the max_daily_approvals field.
+func ByMaxDailyApprovals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDailyApprovals, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByChargeRequestsCount orders the results by charge_requests count.
+func ByChargeRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChargeRequestsStep(), opts...)
+	}
+}
+
+// ByChargeRequests orders the results by charge_requests terms.
+func ByChargeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChargeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newChargeRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChargeRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChargeRequestsTable, ChargeRequestsColumn),
+	)
+}
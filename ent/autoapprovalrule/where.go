@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package autoapprovalrule
+
+import (
+	"somapay-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldName, v))
+}
+
+// MaxAmount applies equality check predicate on the "max_amount" field. It's identical to MaxAmountEQ.
+func MaxAmount(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldRole, v))
+}
+
+// MaxDailyApprovals applies equality check predicate on the "max_daily_approvals" field. It's identical to MaxDailyApprovalsEQ.
+func MaxDailyApprovals(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldMaxDailyApprovals, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// MaxAmountEQ applies the EQ predicate on the "max_amount" field.
+func MaxAmountEQ(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountNEQ applies the NEQ predicate on the "max_amount" field.
+func MaxAmountNEQ(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountIn applies the In predicate on the "max_amount" field.
+func MaxAmountIn(vs ...int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountNotIn applies the NotIn predicate on the "max_amount" field.
+func MaxAmountNotIn(vs ...int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountGT applies the GT predicate on the "max_amount" field.
+func MaxAmountGT(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldMaxAmount, v))
+}
+
+// MaxAmountGTE applies the GTE predicate on the "max_amount" field.
+func MaxAmountGTE(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldMaxAmount, v))
+}
+
+// MaxAmountLT applies the LT predicate on the "max_amount" field.
+func MaxAmountLT(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldMaxAmount, v))
+}
+
+// MaxAmountLTE applies the LTE predicate on the "max_amount" field.
+func MaxAmountLTE(v int64) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldMaxAmount, v))
+}
+
+// MaxAmountIsNil applies the IsNil predicate on the "max_amount" field.
+func MaxAmountIsNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIsNull(FieldMaxAmount))
+}
+
+// MaxAmountNotNil applies the NotNil predicate on the "max_amount" field.
+func MaxAmountNotNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotNull(FieldMaxAmount))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIsNull(FieldRole))
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotNull(FieldRole))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldContainsFold(FieldRole, v))
+}
+
+// MaxDailyApprovalsEQ applies the EQ predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsEQ(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsNEQ applies the NEQ predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsNEQ(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsIn applies the In predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsIn(vs ...int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldMaxDailyApprovals, vs...))
+}
+
+// MaxDailyApprovalsNotIn applies the NotIn predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsNotIn(vs ...int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldMaxDailyApprovals, vs...))
+}
+
+// MaxDailyApprovalsGT applies the GT predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsGT(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsGTE applies the GTE predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsGTE(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsLT applies the LT predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsLT(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsLTE applies the LTE predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsLTE(v int) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldMaxDailyApprovals, v))
+}
+
+// MaxDailyApprovalsIsNil applies the IsNil predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsIsNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIsNull(FieldMaxDailyApprovals))
+}
+
+// MaxDailyApprovalsNotNil applies the NotNil predicate on the "max_daily_approvals" field.
+func MaxDailyApprovalsNotNil() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotNull(FieldMaxDailyApprovals))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasChargeRequests applies the HasEdge predicate on the "charge_requests" edge.
+func HasChargeRequests() predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChargeRequestsTable, ChargeRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChargeRequestsWith applies the HasEdge predicate on the "charge_requests" edge with a given conditions (other predicates).
+func HasChargeRequestsWith(preds ...predicate.ChargeRequest) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(func(s *sql.Selector) {
+		step := newChargeRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AutoApprovalRule) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AutoApprovalRule) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AutoApprovalRule) predicate.AutoApprovalRule {
+	return predicate.AutoApprovalRule(sql.NotPredicates(p))
+}
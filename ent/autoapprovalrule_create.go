@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/chargerequest"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoApprovalRuleCreate is the builder for creating a AutoApprovalRule entity.
+type AutoApprovalRuleCreate struct {
+	config
+	mutation *AutoApprovalRuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *AutoApprovalRuleCreate) SetName(v string) *AutoApprovalRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_c *AutoApprovalRuleCreate) SetMaxAmount(v int64) *AutoApprovalRuleCreate {
+	_c.mutation.SetMaxAmount(v)
+	return _c
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_c *AutoApprovalRuleCreate) SetNillableMaxAmount(v *int64) *AutoApprovalRuleCreate {
+	if v != nil {
+		_c.SetMaxAmount(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *AutoApprovalRuleCreate) SetRole(v string) *AutoApprovalRuleCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *AutoApprovalRuleCreate) SetNillableRole(v *string) *AutoApprovalRuleCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetMaxDailyApprovals sets the "max_daily_approvals" field.
+func (_c *AutoApprovalRuleCreate) SetMaxDailyApprovals(v int) *AutoApprovalRuleCreate {
+	_c.mutation.SetMaxDailyApprovals(v)
+	return _c
+}
+
+// SetNillableMaxDailyApprovals sets the "max_daily_approvals" field if the given value is not nil.
+func (_c *AutoApprovalRuleCreate) SetNillableMaxDailyApprovals(v *int) *AutoApprovalRuleCreate {
+	if v != nil {
+		_c.SetMaxDailyApprovals(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AutoApprovalRuleCreate) SetEnabled(v bool) *AutoApprovalRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AutoApprovalRuleCreate) SetNillableEnabled(v *bool) *AutoApprovalRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AutoApprovalRuleCreate) SetCreatedAt(v time.Time) *AutoApprovalRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AutoApprovalRuleCreate) SetNillableCreatedAt(v *time.Time) *AutoApprovalRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// AddChargeRequestIDs adds the "charge_requests" edge to the ChargeRequest entity by IDs.
+func (_c *AutoApprovalRuleCreate) AddChargeRequestIDs(ids ...int) *AutoApprovalRuleCreate {
+	_c.mutation.AddChargeRequestIDs(ids...)
+	return _c
+}
+
+// AddChargeRequests adds the "charge_requests" edges to the ChargeRequest entity.
+func (_c *AutoApprovalRuleCreate) AddChargeRequests(v ...*ChargeRequest) *AutoApprovalRuleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChargeRequestIDs(ids...)
+}
+
+// Mutation returns the AutoApprovalRuleMutation object of the builder.
+func (_c *AutoApprovalRuleCreate) Mutation() *AutoApprovalRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the AutoApprovalRule in the database.
+func (_c *AutoApprovalRuleCreate) Save(ctx context.Context) (*AutoApprovalRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AutoApprovalRuleCreate) SaveX(ctx context.Context) *AutoApprovalRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutoApprovalRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutoApprovalRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AutoApprovalRuleCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := autoapprovalrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := autoapprovalrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AutoApprovalRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AutoApprovalRule.name"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AutoApprovalRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutoApprovalRule.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AutoApprovalRuleCreate) sqlSave(ctx context.Context) (*AutoApprovalRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AutoApprovalRuleCreate) createSpec() (*AutoApprovalRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutoApprovalRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(autoapprovalrule.Table, sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(autoapprovalrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.MaxAmount(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxAmount, field.TypeInt64, value)
+		_node.MaxAmount = &value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(autoapprovalrule.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.MaxDailyApprovals(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt, value)
+		_node.MaxDailyApprovals = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(autoapprovalrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(autoapprovalrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ChargeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AutoApprovalRuleCreateBulk is the builder for creating many AutoApprovalRule entities in bulk.
+type AutoApprovalRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AutoApprovalRuleCreate
+}
+
+// Save creates the AutoApprovalRule entities in the database.
+func (_c *AutoApprovalRuleCreateBulk) Save(ctx context.Context) ([]*AutoApprovalRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AutoApprovalRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutoApprovalRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AutoApprovalRuleCreateBulk) SaveX(ctx context.Context) []*AutoApprovalRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutoApprovalRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutoApprovalRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoApprovalRuleDelete is the builder for deleting a AutoApprovalRule entity.
+type AutoApprovalRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *AutoApprovalRuleMutation
+}
+
+// Where appends a list predicates to the AutoApprovalRuleDelete builder.
+func (_d *AutoApprovalRuleDelete) Where(ps ...predicate.AutoApprovalRule) *AutoApprovalRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AutoApprovalRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AutoApprovalRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AutoApprovalRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(autoapprovalrule.Table, sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AutoApprovalRuleDeleteOne is the builder for deleting a single AutoApprovalRule entity.
+type AutoApprovalRuleDeleteOne struct {
+	_d *AutoApprovalRuleDelete
+}
+
+// Where appends a list predicates to the AutoApprovalRuleDelete builder.
+func (_d *AutoApprovalRuleDeleteOne) Where(ps ...predicate.AutoApprovalRule) *AutoApprovalRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AutoApprovalRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{autoapprovalrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AutoApprovalRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoApprovalRuleQuery is the builder for querying AutoApprovalRule entities.
+type AutoApprovalRuleQuery struct {
+	config
+	ctx                *QueryContext
+	order              []autoapprovalrule.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.AutoApprovalRule
+	withChargeRequests *ChargeRequestQuery
+	modifiers          []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AutoApprovalRuleQuery builder.
+func (_q *AutoApprovalRuleQuery) Where(ps ...predicate.AutoApprovalRule) *AutoApprovalRuleQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *AutoApprovalRuleQuery) Limit(limit int) *AutoApprovalRuleQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *AutoApprovalRuleQuery) Offset(offset int) *AutoApprovalRuleQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *AutoApprovalRuleQuery) Unique(unique bool) *AutoApprovalRuleQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *AutoApprovalRuleQuery) Order(o ...autoapprovalrule.OrderOption) *AutoApprovalRuleQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryChargeRequests chains the current query on the "charge_requests" edge.
+func (_q *AutoApprovalRuleQuery) QueryChargeRequests() *ChargeRequestQuery {
+	query := (&ChargeRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(autoapprovalrule.Table, autoapprovalrule.FieldID, selector),
+			sqlgraph.To(chargerequest.Table, chargerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, autoapprovalrule.ChargeRequestsTable, autoapprovalrule.ChargeRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AutoApprovalRule entity from the query.
+// Returns a *NotFoundError when no AutoApprovalRule was found.
+func (_q *AutoApprovalRuleQuery) First(ctx context.Context) (*AutoApprovalRule, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{autoapprovalrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) FirstX(ctx context.Context) *AutoApprovalRule {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AutoApprovalRule ID from the query.
+// Returns a *NotFoundError when no AutoApprovalRule ID was found.
+func (_q *AutoApprovalRuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{autoapprovalrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AutoApprovalRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AutoApprovalRule entity is found.
+// Returns a *NotFoundError when no AutoApprovalRule entities are found.
+func (_q *AutoApprovalRuleQuery) Only(ctx context.Context) (*AutoApprovalRule, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{autoapprovalrule.Label}
+	default:
+		return nil, &NotSingularError{autoapprovalrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) OnlyX(ctx context.Context) *AutoApprovalRule {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AutoApprovalRule ID in the query.
+// Returns a *NotSingularError when more than one AutoApprovalRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *AutoApprovalRuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{autoapprovalrule.Label}
+	default:
+		err = &NotSingularError{autoapprovalrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AutoApprovalRules.
+func (_q *AutoApprovalRuleQuery) All(ctx context.Context) ([]*AutoApprovalRule, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AutoApprovalRule, *AutoApprovalRuleQuery]()
+	return withInterceptors[[]*AutoApprovalRule](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) AllX(ctx context.Context) []*AutoApprovalRule {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AutoApprovalRule IDs.
+func (_q *AutoApprovalRuleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(autoapprovalrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *AutoApprovalRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*AutoApprovalRuleQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *AutoApprovalRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *AutoApprovalRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AutoApprovalRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *AutoApprovalRuleQuery) Clone() *AutoApprovalRuleQuery {
+	if _q == nil {
+		return nil
+	}
+	return &AutoApprovalRuleQuery{
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]autoapprovalrule.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.AutoApprovalRule{}, _q.predicates...),
+		withChargeRequests: _q.withChargeRequests.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithChargeRequests tells the query-builder to eager-load the nodes that are connected to
+// the "charge_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AutoApprovalRuleQuery) WithChargeRequests(opts ...func(*ChargeRequestQuery)) *AutoApprovalRuleQuery {
+	query := (&ChargeRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChargeRequests = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AutoApprovalRule.Query().
+//		GroupBy(autoapprovalrule.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *AutoApprovalRuleQuery) GroupBy(field string, fields ...string) *AutoApprovalRuleGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AutoApprovalRuleGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = autoapprovalrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.AutoApprovalRule.Query().
+//		Select(autoapprovalrule.FieldName).
+//		Scan(ctx, &v)
+func (_q *AutoApprovalRuleQuery) Select(fields ...string) *AutoApprovalRuleSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &AutoApprovalRuleSelect{AutoApprovalRuleQuery: _q}
+	sbuild.label = autoapprovalrule.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AutoApprovalRuleSelect configured with the given aggregations.
+func (_q *AutoApprovalRuleQuery) Aggregate(fns ...AggregateFunc) *AutoApprovalRuleSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *AutoApprovalRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !autoapprovalrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *AutoApprovalRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AutoApprovalRule, error) {
+	var (
+		nodes       = []*AutoApprovalRule{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withChargeRequests != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AutoApprovalRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AutoApprovalRule{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withChargeRequests; query != nil {
+		if err := _q.loadChargeRequests(ctx, query, nodes,
+			func(n *AutoApprovalRule) { n.Edges.ChargeRequests = []*ChargeRequest{} },
+			func(n *AutoApprovalRule, e *ChargeRequest) {
+				n.Edges.ChargeRequests = append(n.Edges.ChargeRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *AutoApprovalRuleQuery) loadChargeRequests(ctx context.Context, query *ChargeRequestQuery, nodes []*AutoApprovalRule, init func(*AutoApprovalRule), assign func(*AutoApprovalRule, *ChargeRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AutoApprovalRule)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ChargeRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(autoapprovalrule.ChargeRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.auto_approval_rule_charge_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "auto_approval_rule_charge_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "auto_approval_rule_charge_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *AutoApprovalRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *AutoApprovalRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(autoapprovalrule.Table, autoapprovalrule.Columns, sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, autoapprovalrule.FieldID)
+		for i := range fields {
+			if fields[i] != autoapprovalrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *AutoApprovalRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(autoapprovalrule.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = autoapprovalrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *AutoApprovalRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *AutoApprovalRuleSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// AutoApprovalRuleGroupBy is the group-by builder for AutoApprovalRule entities.
+type AutoApprovalRuleGroupBy struct {
+	selector
+	build *AutoApprovalRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *AutoApprovalRuleGroupBy) Aggregate(fns ...AggregateFunc) *AutoApprovalRuleGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *AutoApprovalRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AutoApprovalRuleQuery, *AutoApprovalRuleGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *AutoApprovalRuleGroupBy) sqlScan(ctx context.Context, root *AutoApprovalRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AutoApprovalRuleSelect is the builder for selecting fields of AutoApprovalRule entities.
+type AutoApprovalRuleSelect struct {
+	*AutoApprovalRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *AutoApprovalRuleSelect) Aggregate(fns ...AggregateFunc) *AutoApprovalRuleSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *AutoApprovalRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AutoApprovalRuleQuery, *AutoApprovalRuleSelect](ctx, _s.AutoApprovalRuleQuery, _s, _s.inters, v)
+}
+
+func (_s *AutoApprovalRuleSelect) sqlScan(ctx context.Context, root *AutoApprovalRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *AutoApprovalRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *AutoApprovalRuleSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AutoApprovalRuleUpdate is the builder for updating AutoApprovalRule entities.
+type AutoApprovalRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AutoApprovalRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AutoApprovalRuleUpdate builder.
+func (_u *AutoApprovalRuleUpdate) Where(ps ...predicate.AutoApprovalRule) *AutoApprovalRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AutoApprovalRuleUpdate) SetName(v string) *AutoApprovalRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdate) SetNillableName(v *string) *AutoApprovalRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_u *AutoApprovalRuleUpdate) SetMaxAmount(v int64) *AutoApprovalRuleUpdate {
+	_u.mutation.ResetMaxAmount()
+	_u.mutation.SetMaxAmount(v)
+	return _u
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdate) SetNillableMaxAmount(v *int64) *AutoApprovalRuleUpdate {
+	if v != nil {
+		_u.SetMaxAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxAmount adds value to the "max_amount" field.
+func (_u *AutoApprovalRuleUpdate) AddMaxAmount(v int64) *AutoApprovalRuleUpdate {
+	_u.mutation.AddMaxAmount(v)
+	return _u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (_u *AutoApprovalRuleUpdate) ClearMaxAmount() *AutoApprovalRuleUpdate {
+	_u.mutation.ClearMaxAmount()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AutoApprovalRuleUpdate) SetRole(v string) *AutoApprovalRuleUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdate) SetNillableRole(v *string) *AutoApprovalRuleUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *AutoApprovalRuleUpdate) ClearRole() *AutoApprovalRuleUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetMaxDailyApprovals sets the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdate) SetMaxDailyApprovals(v int) *AutoApprovalRuleUpdate {
+	_u.mutation.ResetMaxDailyApprovals()
+	_u.mutation.SetMaxDailyApprovals(v)
+	return _u
+}
+
+// SetNillableMaxDailyApprovals sets the "max_daily_approvals" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdate) SetNillableMaxDailyApprovals(v *int) *AutoApprovalRuleUpdate {
+	if v != nil {
+		_u.SetMaxDailyApprovals(*v)
+	}
+	return _u
+}
+
+// AddMaxDailyApprovals adds value to the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdate) AddMaxDailyApprovals(v int) *AutoApprovalRuleUpdate {
+	_u.mutation.AddMaxDailyApprovals(v)
+	return _u
+}
+
+// ClearMaxDailyApprovals clears the value of the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdate) ClearMaxDailyApprovals() *AutoApprovalRuleUpdate {
+	_u.mutation.ClearMaxDailyApprovals()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutoApprovalRuleUpdate) SetEnabled(v bool) *AutoApprovalRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdate) SetNillableEnabled(v *bool) *AutoApprovalRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddChargeRequestIDs adds the "charge_requests" edge to the ChargeRequest entity by IDs.
+func (_u *AutoApprovalRuleUpdate) AddChargeRequestIDs(ids ...int) *AutoApprovalRuleUpdate {
+	_u.mutation.AddChargeRequestIDs(ids...)
+	return _u
+}
+
+// AddChargeRequests adds the "charge_requests" edges to the ChargeRequest entity.
+func (_u *AutoApprovalRuleUpdate) AddChargeRequests(v ...*ChargeRequest) *AutoApprovalRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChargeRequestIDs(ids...)
+}
+
+// Mutation returns the AutoApprovalRuleMutation object of the builder.
+func (_u *AutoApprovalRuleUpdate) Mutation() *AutoApprovalRuleMutation {
+	return _u.mutation
+}
+
+// ClearChargeRequests clears all "charge_requests" edges to the ChargeRequest entity.
+func (_u *AutoApprovalRuleUpdate) ClearChargeRequests() *AutoApprovalRuleUpdate {
+	_u.mutation.ClearChargeRequests()
+	return _u
+}
+
+// RemoveChargeRequestIDs removes the "charge_requests" edge to ChargeRequest entities by IDs.
+func (_u *AutoApprovalRuleUpdate) RemoveChargeRequestIDs(ids ...int) *AutoApprovalRuleUpdate {
+	_u.mutation.RemoveChargeRequestIDs(ids...)
+	return _u
+}
+
+// RemoveChargeRequests removes "charge_requests" edges to ChargeRequest entities.
+func (_u *AutoApprovalRuleUpdate) RemoveChargeRequests(v ...*ChargeRequest) *AutoApprovalRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChargeRequestIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AutoApprovalRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutoApprovalRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AutoApprovalRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutoApprovalRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AutoApprovalRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AutoApprovalRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AutoApprovalRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(autoapprovalrule.Table, autoapprovalrule.Columns, sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(autoapprovalrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxAmount(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(autoapprovalrule.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxAmountCleared() {
+		_spec.ClearField(autoapprovalrule.FieldMaxAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(autoapprovalrule.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(autoapprovalrule.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxDailyApprovals(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDailyApprovals(); ok {
+		_spec.AddField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDailyApprovalsCleared() {
+		_spec.ClearField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(autoapprovalrule.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.ChargeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChargeRequestsIDs(); len(nodes) > 0 && !_u.mutation.ChargeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChargeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autoapprovalrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AutoApprovalRuleUpdateOne is the builder for updating a single AutoApprovalRule entity.
+type AutoApprovalRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AutoApprovalRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *AutoApprovalRuleUpdateOne) SetName(v string) *AutoApprovalRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdateOne) SetNillableName(v *string) *AutoApprovalRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (_u *AutoApprovalRuleUpdateOne) SetMaxAmount(v int64) *AutoApprovalRuleUpdateOne {
+	_u.mutation.ResetMaxAmount()
+	_u.mutation.SetMaxAmount(v)
+	return _u
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdateOne) SetNillableMaxAmount(v *int64) *AutoApprovalRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxAmount adds value to the "max_amount" field.
+func (_u *AutoApprovalRuleUpdateOne) AddMaxAmount(v int64) *AutoApprovalRuleUpdateOne {
+	_u.mutation.AddMaxAmount(v)
+	return _u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (_u *AutoApprovalRuleUpdateOne) ClearMaxAmount() *AutoApprovalRuleUpdateOne {
+	_u.mutation.ClearMaxAmount()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AutoApprovalRuleUpdateOne) SetRole(v string) *AutoApprovalRuleUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdateOne) SetNillableRole(v *string) *AutoApprovalRuleUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *AutoApprovalRuleUpdateOne) ClearRole() *AutoApprovalRuleUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetMaxDailyApprovals sets the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdateOne) SetMaxDailyApprovals(v int) *AutoApprovalRuleUpdateOne {
+	_u.mutation.ResetMaxDailyApprovals()
+	_u.mutation.SetMaxDailyApprovals(v)
+	return _u
+}
+
+// SetNillableMaxDailyApprovals sets the "max_daily_approvals" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdateOne) SetNillableMaxDailyApprovals(v *int) *AutoApprovalRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxDailyApprovals(*v)
+	}
+	return _u
+}
+
+// AddMaxDailyApprovals adds value to the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdateOne) AddMaxDailyApprovals(v int) *AutoApprovalRuleUpdateOne {
+	_u.mutation.AddMaxDailyApprovals(v)
+	return _u
+}
+
+// ClearMaxDailyApprovals clears the value of the "max_daily_approvals" field.
+func (_u *AutoApprovalRuleUpdateOne) ClearMaxDailyApprovals() *AutoApprovalRuleUpdateOne {
+	_u.mutation.ClearMaxDailyApprovals()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutoApprovalRuleUpdateOne) SetEnabled(v bool) *AutoApprovalRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutoApprovalRuleUpdateOne) SetNillableEnabled(v *bool) *AutoApprovalRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddChargeRequestIDs adds the "charge_requests" edge to the ChargeRequest entity by IDs.
+func (_u *AutoApprovalRuleUpdateOne) AddChargeRequestIDs(ids ...int) *AutoApprovalRuleUpdateOne {
+	_u.mutation.AddChargeRequestIDs(ids...)
+	return _u
+}
+
+// AddChargeRequests adds the "charge_requests" edges to the ChargeRequest entity.
+func (_u *AutoApprovalRuleUpdateOne) AddChargeRequests(v ...*ChargeRequest) *AutoApprovalRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChargeRequestIDs(ids...)
+}
+
+// Mutation returns the AutoApprovalRuleMutation object of the builder.
+func (_u *AutoApprovalRuleUpdateOne) Mutation() *AutoApprovalRuleMutation {
+	return _u.mutation
+}
+
+// ClearChargeRequests clears all "charge_requests" edges to the ChargeRequest entity.
+func (_u *AutoApprovalRuleUpdateOne) ClearChargeRequests() *AutoApprovalRuleUpdateOne {
+	_u.mutation.ClearChargeRequests()
+	return _u
+}
+
+// RemoveChargeRequestIDs removes the "charge_requests" edge to ChargeRequest entities by IDs.
+func (_u *AutoApprovalRuleUpdateOne) RemoveChargeRequestIDs(ids ...int) *AutoApprovalRuleUpdateOne {
+	_u.mutation.RemoveChargeRequestIDs(ids...)
+	return _u
+}
+
+// RemoveChargeRequests removes "charge_requests" edges to ChargeRequest entities.
+func (_u *AutoApprovalRuleUpdateOne) RemoveChargeRequests(v ...*ChargeRequest) *AutoApprovalRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChargeRequestIDs(ids...)
+}
+
+// Where appends a list predicates to the AutoApprovalRuleUpdate builder.
+func (_u *AutoApprovalRuleUpdateOne) Where(ps ...predicate.AutoApprovalRule) *AutoApprovalRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AutoApprovalRuleUpdateOne) Select(field string, fields ...string) *AutoApprovalRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AutoApprovalRule entity.
+func (_u *AutoApprovalRuleUpdateOne) Save(ctx context.Context) (*AutoApprovalRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutoApprovalRuleUpdateOne) SaveX(ctx context.Context) *AutoApprovalRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AutoApprovalRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutoApprovalRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AutoApprovalRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AutoApprovalRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AutoApprovalRuleUpdateOne) sqlSave(ctx context.Context) (_node *AutoApprovalRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(autoapprovalrule.Table, autoapprovalrule.Columns, sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutoApprovalRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, autoapprovalrule.FieldID)
+		for _, f := range fields {
+			if !autoapprovalrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != autoapprovalrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(autoapprovalrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxAmount(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(autoapprovalrule.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxAmountCleared() {
+		_spec.ClearField(autoapprovalrule.FieldMaxAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(autoapprovalrule.FieldRole, field.TypeString, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(autoapprovalrule.FieldRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxDailyApprovals(); ok {
+		_spec.SetField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDailyApprovals(); ok {
+		_spec.AddField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt, value)
+	}
+	if _u.mutation.MaxDailyApprovalsCleared() {
+		_spec.ClearField(autoapprovalrule.FieldMaxDailyApprovals, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(autoapprovalrule.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.ChargeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChargeRequestsIDs(); len(nodes) > 0 && !_u.mutation.ChargeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChargeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   autoapprovalrule.ChargeRequestsTable,
+			Columns: []string{autoapprovalrule.ChargeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chargerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AutoApprovalRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{autoapprovalrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,6 +19,8 @@ type Booth struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// OrderSeq holds the value of the "order_seq" field.
+	OrderSeq int `json:"order_seq,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BoothQuery when eager-loading is set.
 	Edges        BoothEdges `json:"edges"`
@@ -73,7 +75,7 @@ func (*Booth) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case booth.FieldID:
+		case booth.FieldID, booth.FieldOrderSeq:
 			values[i] = new(sql.NullInt64)
 		case booth.FieldName:
 			values[i] = new(sql.NullString)
@@ -106,6 +108,12 @@ func (_m *Booth) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case booth.FieldOrderSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_seq", values[i])
+			} else if value.Valid {
+				_m.OrderSeq = int(value.Int64)
+			}
 		case booth.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_booth", value)
@@ -166,6 +174,9 @@ func (_m *Booth) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("order_seq=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderSeq))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,6 +14,8 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldOrderSeq holds the string denoting the order_seq field in the database.
+	FieldOrderSeq = "order_seq"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeProducts holds the string denoting the products edge name in mutations.
@@ -49,6 +51,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldOrderSeq,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "booths"
@@ -72,6 +75,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultOrderSeq holds the default value on creation for the "order_seq" field.
+	DefaultOrderSeq int
+)
+
 // OrderOption defines the ordering options for the Booth queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,6 +93,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByOrderSeq orders the results by the order_seq field.
+func ByOrderSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderSeq, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -59,6 +59,11 @@ func Name(v string) predicate.Booth {
 	return predicate.Booth(sql.FieldEQ(FieldName, v))
 }
 
+// OrderSeq applies equality check predicate on the "order_seq" field. It's identical to OrderSeqEQ.
+func OrderSeq(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldEQ(FieldOrderSeq, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Booth {
 	return predicate.Booth(sql.FieldEQ(FieldName, v))
@@ -124,6 +129,46 @@ func NameContainsFold(v string) predicate.Booth {
 	return predicate.Booth(sql.FieldContainsFold(FieldName, v))
 }
 
+// OrderSeqEQ applies the EQ predicate on the "order_seq" field.
+func OrderSeqEQ(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldEQ(FieldOrderSeq, v))
+}
+
+// OrderSeqNEQ applies the NEQ predicate on the "order_seq" field.
+func OrderSeqNEQ(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldNEQ(FieldOrderSeq, v))
+}
+
+// OrderSeqIn applies the In predicate on the "order_seq" field.
+func OrderSeqIn(vs ...int) predicate.Booth {
+	return predicate.Booth(sql.FieldIn(FieldOrderSeq, vs...))
+}
+
+// OrderSeqNotIn applies the NotIn predicate on the "order_seq" field.
+func OrderSeqNotIn(vs ...int) predicate.Booth {
+	return predicate.Booth(sql.FieldNotIn(FieldOrderSeq, vs...))
+}
+
+// OrderSeqGT applies the GT predicate on the "order_seq" field.
+func OrderSeqGT(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldGT(FieldOrderSeq, v))
+}
+
+// OrderSeqGTE applies the GTE predicate on the "order_seq" field.
+func OrderSeqGTE(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldGTE(FieldOrderSeq, v))
+}
+
+// OrderSeqLT applies the LT predicate on the "order_seq" field.
+func OrderSeqLT(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldLT(FieldOrderSeq, v))
+}
+
+// OrderSeqLTE applies the LTE predicate on the "order_seq" field.
+func OrderSeqLTE(v int) predicate.Booth {
+	return predicate.Booth(sql.FieldLTE(FieldOrderSeq, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Booth {
 	return predicate.Booth(func(s *sql.Selector) {
@@ -28,6 +28,20 @@ func (_c *BoothCreate) SetName(v string) *BoothCreate {
 	return _c
 }
 
+// SetOrderSeq sets the "order_seq" field.
+func (_c *BoothCreate) SetOrderSeq(v int) *BoothCreate {
+	_c.mutation.SetOrderSeq(v)
+	return _c
+}
+
+// SetNillableOrderSeq sets the "order_seq" field if the given value is not nil.
+func (_c *BoothCreate) SetNillableOrderSeq(v *int) *BoothCreate {
+	if v != nil {
+		_c.SetOrderSeq(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *BoothCreate) SetUserID(id int) *BoothCreate {
 	_c.mutation.SetUserID(id)
@@ -76,6 +90,7 @@ func (_c *BoothCreate) Mutation() *BoothMutation {
 
 // Save creates the Booth in the database.
 func (_c *BoothCreate) Save(ctx context.Context) (*Booth, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -101,11 +116,22 @@ func (_c *BoothCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *BoothCreate) defaults() {
+	if _, ok := _c.mutation.OrderSeq(); !ok {
+		v := booth.DefaultOrderSeq
+		_c.mutation.SetOrderSeq(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *BoothCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Booth.name"`)}
 	}
+	if _, ok := _c.mutation.OrderSeq(); !ok {
+		return &ValidationError{Name: "order_seq", err: errors.New(`ent: missing required field "Booth.order_seq"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Booth.user"`)}
 	}
@@ -139,6 +165,10 @@ func (_c *BoothCreate) createSpec() (*Booth, *sqlgraph.CreateSpec) {
 		_spec.SetField(booth.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.OrderSeq(); ok {
+		_spec.SetField(booth.FieldOrderSeq, field.TypeInt, value)
+		_node.OrderSeq = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -209,6 +239,7 @@ func (_c *BoothCreateBulk) Save(ctx context.Context) ([]*Booth, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*BoothMutation)
 				if !ok {
@@ -30,6 +30,7 @@ type BoothQuery struct {
 	withProducts     *ProductQuery
 	withTransactions *TransactionQuery
 	withFKs          bool
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -328,8 +329,9 @@ func (_q *BoothQuery) Clone() *BoothQuery {
 		withProducts:     _q.withProducts.Clone(),
 		withTransactions: _q.withTransactions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -466,6 +468,9 @@ func (_q *BoothQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Booth,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -571,7 +576,9 @@ func (_q *BoothQuery) loadTransactions(ctx context.Context, query *TransactionQu
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldBoothID)
+	}
 	query.Where(predicate.Transaction(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(booth.TransactionsColumn), fks...))
 	}))
@@ -580,13 +587,10 @@ func (_q *BoothQuery) loadTransactions(ctx context.Context, query *TransactionQu
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.booth_transactions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "booth_transactions" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.BoothID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "booth_transactions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "booth_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -595,6 +599,9 @@ func (_q *BoothQuery) loadTransactions(ctx context.Context, query *TransactionQu
 
 func (_q *BoothQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -657,6 +664,9 @@ func (_q *BoothQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -674,6 +684,12 @@ func (_q *BoothQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *BoothQuery) Modify(modifiers ...func(s *sql.Selector)) *BoothSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // BoothGroupBy is the group-by builder for Booth entities.
 type BoothGroupBy struct {
 	selector
@@ -763,3 +779,9 @@ func (_s *BoothSelect) sqlScan(ctx context.Context, root *BoothQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *BoothSelect) Modify(modifiers ...func(s *sql.Selector)) *BoothSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -20,8 +20,9 @@ import (
 // BoothUpdate is the builder for updating Booth entities.
 type BoothUpdate struct {
 	config
-	hooks    []Hook
-	mutation *BoothMutation
+	hooks     []Hook
+	mutation  *BoothMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the BoothUpdate builder.
@@ -44,6 +45,27 @@ func (_u *BoothUpdate) SetNillableName(v *string) *BoothUpdate {
 	return _u
 }
 
+// SetOrderSeq sets the "order_seq" field.
+func (_u *BoothUpdate) SetOrderSeq(v int) *BoothUpdate {
+	_u.mutation.ResetOrderSeq()
+	_u.mutation.SetOrderSeq(v)
+	return _u
+}
+
+// SetNillableOrderSeq sets the "order_seq" field if the given value is not nil.
+func (_u *BoothUpdate) SetNillableOrderSeq(v *int) *BoothUpdate {
+	if v != nil {
+		_u.SetOrderSeq(*v)
+	}
+	return _u
+}
+
+// AddOrderSeq adds value to the "order_seq" field.
+func (_u *BoothUpdate) AddOrderSeq(v int) *BoothUpdate {
+	_u.mutation.AddOrderSeq(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *BoothUpdate) SetUserID(id int) *BoothUpdate {
 	_u.mutation.SetUserID(id)
@@ -173,6 +195,12 @@ func (_u *BoothUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BoothUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BoothUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *BoothUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -188,6 +216,12 @@ func (_u *BoothUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(booth.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OrderSeq(); ok {
+		_spec.SetField(booth.FieldOrderSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderSeq(); ok {
+		_spec.AddField(booth.FieldOrderSeq, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -307,6 +341,7 @@ func (_u *BoothUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{booth.Label}
@@ -322,9 +357,10 @@ func (_u *BoothUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // BoothUpdateOne is the builder for updating a single Booth entity.
 type BoothUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *BoothMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *BoothMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -341,6 +377,27 @@ func (_u *BoothUpdateOne) SetNillableName(v *string) *BoothUpdateOne {
 	return _u
 }
 
+// SetOrderSeq sets the "order_seq" field.
+func (_u *BoothUpdateOne) SetOrderSeq(v int) *BoothUpdateOne {
+	_u.mutation.ResetOrderSeq()
+	_u.mutation.SetOrderSeq(v)
+	return _u
+}
+
+// SetNillableOrderSeq sets the "order_seq" field if the given value is not nil.
+func (_u *BoothUpdateOne) SetNillableOrderSeq(v *int) *BoothUpdateOne {
+	if v != nil {
+		_u.SetOrderSeq(*v)
+	}
+	return _u
+}
+
+// AddOrderSeq adds value to the "order_seq" field.
+func (_u *BoothUpdateOne) AddOrderSeq(v int) *BoothUpdateOne {
+	_u.mutation.AddOrderSeq(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *BoothUpdateOne) SetUserID(id int) *BoothUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -483,6 +540,12 @@ func (_u *BoothUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BoothUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BoothUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *BoothUpdateOne) sqlSave(ctx context.Context) (_node *Booth, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -515,6 +578,12 @@ func (_u *BoothUpdateOne) sqlSave(ctx context.Context) (_node *Booth, err error)
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(booth.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OrderSeq(); ok {
+		_spec.SetField(booth.FieldOrderSeq, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderSeq(); ok {
+		_spec.AddField(booth.FieldOrderSeq, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -634,6 +703,7 @@ func (_u *BoothUpdateOne) sqlSave(ctx context.Context) (_node *Booth, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Booth{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -4,9 +4,11 @@ package ent
 
 import (
 	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,21 +23,32 @@ type ChargeRequest struct {
 	Amount int64 `json:"amount,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// DepositorName holds the value of the "depositor_name" field.
+	DepositorName string `json:"depositor_name,omitempty"`
+	// PaymentMethod holds the value of the "payment_method" field.
+	PaymentMethod string `json:"payment_method,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChargeRequestQuery when eager-loading is set.
-	Edges                ChargeRequestEdges `json:"edges"`
-	charge_request_user  *int
-	user_charge_requests *int
-	selectValues         sql.SelectValues
+	Edges                              ChargeRequestEdges `json:"edges"`
+	auto_approval_rule_charge_requests *int
+	charge_request_user                *int
+	user_charge_requests               *int
+	selectValues                       sql.SelectValues
 }
 
 // ChargeRequestEdges holds the relations/edges for other nodes in the graph.
 type ChargeRequestEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Rule holds the value of the rule edge.
+	Rule *AutoApprovalRule `json:"rule,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -49,6 +62,17 @@ func (e ChargeRequestEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// RuleOrErr returns the Rule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChargeRequestEdges) RuleOrErr() (*AutoApprovalRule, error) {
+	if e.Rule != nil {
+		return e.Rule, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: autoapprovalrule.Label}
+	}
+	return nil, &NotLoadedError{edge: "rule"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ChargeRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -56,11 +80,15 @@ func (*ChargeRequest) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case chargerequest.FieldID, chargerequest.FieldAmount:
 			values[i] = new(sql.NullInt64)
-		case chargerequest.FieldStatus:
+		case chargerequest.FieldStatus, chargerequest.FieldDepositorName, chargerequest.FieldPaymentMethod:
 			values[i] = new(sql.NullString)
-		case chargerequest.ForeignKeys[0]: // charge_request_user
+		case chargerequest.FieldCreatedAt, chargerequest.FieldDecidedAt:
+			values[i] = new(sql.NullTime)
+		case chargerequest.ForeignKeys[0]: // auto_approval_rule_charge_requests
+			values[i] = new(sql.NullInt64)
+		case chargerequest.ForeignKeys[1]: // charge_request_user
 			values[i] = new(sql.NullInt64)
-		case chargerequest.ForeignKeys[1]: // user_charge_requests
+		case chargerequest.ForeignKeys[2]: // user_charge_requests
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -95,14 +123,46 @@ func (_m *ChargeRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case chargerequest.FieldDepositorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field depositor_name", values[i])
+			} else if value.Valid {
+				_m.DepositorName = value.String
+			}
+		case chargerequest.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				_m.PaymentMethod = value.String
+			}
+		case chargerequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case chargerequest.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				_m.DecidedAt = new(time.Time)
+				*_m.DecidedAt = value.Time
+			}
 		case chargerequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field auto_approval_rule_charge_requests", value)
+			} else if value.Valid {
+				_m.auto_approval_rule_charge_requests = new(int)
+				*_m.auto_approval_rule_charge_requests = int(value.Int64)
+			}
+		case chargerequest.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field charge_request_user", value)
 			} else if value.Valid {
 				_m.charge_request_user = new(int)
 				*_m.charge_request_user = int(value.Int64)
 			}
-		case chargerequest.ForeignKeys[1]:
+		case chargerequest.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_charge_requests", value)
 			} else if value.Valid {
@@ -127,6 +187,11 @@ func (_m *ChargeRequest) QueryUser() *UserQuery {
 	return NewChargeRequestClient(_m.config).QueryUser(_m)
 }
 
+// QueryRule queries the "rule" edge of the ChargeRequest entity.
+func (_m *ChargeRequest) QueryRule() *AutoApprovalRuleQuery {
+	return NewChargeRequestClient(_m.config).QueryRule(_m)
+}
+
 // Update returns a builder for updating this ChargeRequest.
 // Note that you need to call ChargeRequest.Unwrap() before calling this method if this ChargeRequest
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -155,6 +220,20 @@ func (_m *ChargeRequest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("depositor_name=")
+	builder.WriteString(_m.DepositorName)
+	builder.WriteString(", ")
+	builder.WriteString("payment_method=")
+	builder.WriteString(_m.PaymentMethod)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package chargerequest
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,8 +18,18 @@ const (
 	FieldAmount = "amount"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldDepositorName holds the string denoting the depositor_name field in the database.
+	FieldDepositorName = "depositor_name"
+	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
+	FieldPaymentMethod = "payment_method"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldDecidedAt holds the string denoting the decided_at field in the database.
+	FieldDecidedAt = "decided_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeRule holds the string denoting the rule edge name in mutations.
+	EdgeRule = "rule"
 	// Table holds the table name of the chargerequest in the database.
 	Table = "charge_requests"
 	// UserTable is the table that holds the user relation/edge.
@@ -27,6 +39,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "charge_request_user"
+	// RuleTable is the table that holds the rule relation/edge.
+	RuleTable = "charge_requests"
+	// RuleInverseTable is the table name for the AutoApprovalRule entity.
+	// It exists in this package in order to avoid circular dependency with the "autoapprovalrule" package.
+	RuleInverseTable = "auto_approval_rules"
+	// RuleColumn is the table column denoting the rule relation/edge.
+	RuleColumn = "auto_approval_rule_charge_requests"
 )
 
 // Columns holds all SQL columns for chargerequest fields.
@@ -34,11 +53,16 @@ var Columns = []string{
 	FieldID,
 	FieldAmount,
 	FieldStatus,
+	FieldDepositorName,
+	FieldPaymentMethod,
+	FieldCreatedAt,
+	FieldDecidedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "charge_requests"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"auto_approval_rule_charge_requests",
 	"charge_request_user",
 	"user_charge_requests",
 }
@@ -61,6 +85,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 )
 
 // OrderOption defines the ordering options for the ChargeRequest queries.
@@ -81,12 +107,39 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByDepositorName orders the results by the depositor_name field.
+func ByDepositorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositorName, opts...).ToFunc()
+}
+
+// ByPaymentMethod orders the results by the payment_method field.
+func ByPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMethod, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDecidedAt orders the results by the decided_at field.
+func ByDecidedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecidedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRuleField orders the results by rule field.
+func ByRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRuleStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -94,3 +147,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
 	)
 }
+func newRuleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RuleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RuleTable, RuleColumn),
+	)
+}
@@ -4,6 +4,7 @@ package chargerequest
 
 import (
 	"somapay-backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -64,6 +65,26 @@ func Status(v string) predicate.ChargeRequest {
 	return predicate.ChargeRequest(sql.FieldEQ(FieldStatus, v))
 }
 
+// DepositorName applies equality check predicate on the "depositor_name" field. It's identical to DepositorNameEQ.
+func DepositorName(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldDepositorName, v))
+}
+
+// PaymentMethod applies equality check predicate on the "payment_method" field. It's identical to PaymentMethodEQ.
+func PaymentMethod(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DecidedAt applies equality check predicate on the "decided_at" field. It's identical to DecidedAtEQ.
+func DecidedAt(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldDecidedAt, v))
+}
+
 // AmountEQ applies the EQ predicate on the "amount" field.
 func AmountEQ(v int64) predicate.ChargeRequest {
 	return predicate.ChargeRequest(sql.FieldEQ(FieldAmount, v))
@@ -169,6 +190,246 @@ func StatusContainsFold(v string) predicate.ChargeRequest {
 	return predicate.ChargeRequest(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// DepositorNameEQ applies the EQ predicate on the "depositor_name" field.
+func DepositorNameEQ(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldDepositorName, v))
+}
+
+// DepositorNameNEQ applies the NEQ predicate on the "depositor_name" field.
+func DepositorNameNEQ(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNEQ(FieldDepositorName, v))
+}
+
+// DepositorNameIn applies the In predicate on the "depositor_name" field.
+func DepositorNameIn(vs ...string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIn(FieldDepositorName, vs...))
+}
+
+// DepositorNameNotIn applies the NotIn predicate on the "depositor_name" field.
+func DepositorNameNotIn(vs ...string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotIn(FieldDepositorName, vs...))
+}
+
+// DepositorNameGT applies the GT predicate on the "depositor_name" field.
+func DepositorNameGT(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGT(FieldDepositorName, v))
+}
+
+// DepositorNameGTE applies the GTE predicate on the "depositor_name" field.
+func DepositorNameGTE(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGTE(FieldDepositorName, v))
+}
+
+// DepositorNameLT applies the LT predicate on the "depositor_name" field.
+func DepositorNameLT(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLT(FieldDepositorName, v))
+}
+
+// DepositorNameLTE applies the LTE predicate on the "depositor_name" field.
+func DepositorNameLTE(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLTE(FieldDepositorName, v))
+}
+
+// DepositorNameContains applies the Contains predicate on the "depositor_name" field.
+func DepositorNameContains(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldContains(FieldDepositorName, v))
+}
+
+// DepositorNameHasPrefix applies the HasPrefix predicate on the "depositor_name" field.
+func DepositorNameHasPrefix(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldHasPrefix(FieldDepositorName, v))
+}
+
+// DepositorNameHasSuffix applies the HasSuffix predicate on the "depositor_name" field.
+func DepositorNameHasSuffix(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldHasSuffix(FieldDepositorName, v))
+}
+
+// DepositorNameIsNil applies the IsNil predicate on the "depositor_name" field.
+func DepositorNameIsNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIsNull(FieldDepositorName))
+}
+
+// DepositorNameNotNil applies the NotNil predicate on the "depositor_name" field.
+func DepositorNameNotNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotNull(FieldDepositorName))
+}
+
+// DepositorNameEqualFold applies the EqualFold predicate on the "depositor_name" field.
+func DepositorNameEqualFold(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEqualFold(FieldDepositorName, v))
+}
+
+// DepositorNameContainsFold applies the ContainsFold predicate on the "depositor_name" field.
+func DepositorNameContainsFold(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldContainsFold(FieldDepositorName, v))
+}
+
+// PaymentMethodEQ applies the EQ predicate on the "payment_method" field.
+func PaymentMethodEQ(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodNEQ applies the NEQ predicate on the "payment_method" field.
+func PaymentMethodNEQ(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodIn applies the In predicate on the "payment_method" field.
+func PaymentMethodIn(vs ...string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodNotIn applies the NotIn predicate on the "payment_method" field.
+func PaymentMethodNotIn(vs ...string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodGT applies the GT predicate on the "payment_method" field.
+func PaymentMethodGT(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodGTE applies the GTE predicate on the "payment_method" field.
+func PaymentMethodGTE(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLT applies the LT predicate on the "payment_method" field.
+func PaymentMethodLT(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLTE applies the LTE predicate on the "payment_method" field.
+func PaymentMethodLTE(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContains applies the Contains predicate on the "payment_method" field.
+func PaymentMethodContains(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldContains(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasPrefix applies the HasPrefix predicate on the "payment_method" field.
+func PaymentMethodHasPrefix(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldHasPrefix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasSuffix applies the HasSuffix predicate on the "payment_method" field.
+func PaymentMethodHasSuffix(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldHasSuffix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodIsNil applies the IsNil predicate on the "payment_method" field.
+func PaymentMethodIsNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIsNull(FieldPaymentMethod))
+}
+
+// PaymentMethodNotNil applies the NotNil predicate on the "payment_method" field.
+func PaymentMethodNotNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotNull(FieldPaymentMethod))
+}
+
+// PaymentMethodEqualFold applies the EqualFold predicate on the "payment_method" field.
+func PaymentMethodEqualFold(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEqualFold(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContainsFold applies the ContainsFold predicate on the "payment_method" field.
+func PaymentMethodContainsFold(v string) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldContainsFold(FieldPaymentMethod, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DecidedAtEQ applies the EQ predicate on the "decided_at" field.
+func DecidedAtEQ(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtNEQ applies the NEQ predicate on the "decided_at" field.
+func DecidedAtNEQ(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtIn applies the In predicate on the "decided_at" field.
+func DecidedAtIn(vs ...time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtNotIn applies the NotIn predicate on the "decided_at" field.
+func DecidedAtNotIn(vs ...time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtGT applies the GT predicate on the "decided_at" field.
+func DecidedAtGT(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGT(FieldDecidedAt, v))
+}
+
+// DecidedAtGTE applies the GTE predicate on the "decided_at" field.
+func DecidedAtGTE(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldGTE(FieldDecidedAt, v))
+}
+
+// DecidedAtLT applies the LT predicate on the "decided_at" field.
+func DecidedAtLT(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLT(FieldDecidedAt, v))
+}
+
+// DecidedAtLTE applies the LTE predicate on the "decided_at" field.
+func DecidedAtLTE(v time.Time) predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldLTE(FieldDecidedAt, v))
+}
+
+// DecidedAtIsNil applies the IsNil predicate on the "decided_at" field.
+func DecidedAtIsNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldIsNull(FieldDecidedAt))
+}
+
+// DecidedAtNotNil applies the NotNil predicate on the "decided_at" field.
+func DecidedAtNotNil() predicate.ChargeRequest {
+	return predicate.ChargeRequest(sql.FieldNotNull(FieldDecidedAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.ChargeRequest {
 	return predicate.ChargeRequest(func(s *sql.Selector) {
@@ -192,6 +453,29 @@ func HasUserWith(preds ...predicate.User) predicate.ChargeRequest {
 	})
 }
 
+// HasRule applies the HasEdge predicate on the "rule" edge.
+func HasRule() predicate.ChargeRequest {
+	return predicate.ChargeRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RuleTable, RuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleWith applies the HasEdge predicate on the "rule" edge with a given conditions (other predicates).
+func HasRuleWith(preds ...predicate.AutoApprovalRule) predicate.ChargeRequest {
+	return predicate.ChargeRequest(func(s *sql.Selector) {
+		step := newRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ChargeRequest) predicate.ChargeRequest {
 	return predicate.ChargeRequest(sql.AndPredicates(predicates...))
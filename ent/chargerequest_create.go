@@ -6,8 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -40,6 +42,62 @@ func (_c *ChargeRequestCreate) SetNillableStatus(v *string) *ChargeRequestCreate
 	return _c
 }
 
+// SetDepositorName sets the "depositor_name" field.
+func (_c *ChargeRequestCreate) SetDepositorName(v string) *ChargeRequestCreate {
+	_c.mutation.SetDepositorName(v)
+	return _c
+}
+
+// SetNillableDepositorName sets the "depositor_name" field if the given value is not nil.
+func (_c *ChargeRequestCreate) SetNillableDepositorName(v *string) *ChargeRequestCreate {
+	if v != nil {
+		_c.SetDepositorName(*v)
+	}
+	return _c
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_c *ChargeRequestCreate) SetPaymentMethod(v string) *ChargeRequestCreate {
+	_c.mutation.SetPaymentMethod(v)
+	return _c
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_c *ChargeRequestCreate) SetNillablePaymentMethod(v *string) *ChargeRequestCreate {
+	if v != nil {
+		_c.SetPaymentMethod(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChargeRequestCreate) SetCreatedAt(v time.Time) *ChargeRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChargeRequestCreate) SetNillableCreatedAt(v *time.Time) *ChargeRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_c *ChargeRequestCreate) SetDecidedAt(v time.Time) *ChargeRequestCreate {
+	_c.mutation.SetDecidedAt(v)
+	return _c
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_c *ChargeRequestCreate) SetNillableDecidedAt(v *time.Time) *ChargeRequestCreate {
+	if v != nil {
+		_c.SetDecidedAt(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *ChargeRequestCreate) SetUserID(id int) *ChargeRequestCreate {
 	_c.mutation.SetUserID(id)
@@ -51,6 +109,25 @@ func (_c *ChargeRequestCreate) SetUser(v *User) *ChargeRequestCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetRuleID sets the "rule" edge to the AutoApprovalRule entity by ID.
+func (_c *ChargeRequestCreate) SetRuleID(id int) *ChargeRequestCreate {
+	_c.mutation.SetRuleID(id)
+	return _c
+}
+
+// SetNillableRuleID sets the "rule" edge to the AutoApprovalRule entity by ID if the given value is not nil.
+func (_c *ChargeRequestCreate) SetNillableRuleID(id *int) *ChargeRequestCreate {
+	if id != nil {
+		_c = _c.SetRuleID(*id)
+	}
+	return _c
+}
+
+// SetRule sets the "rule" edge to the AutoApprovalRule entity.
+func (_c *ChargeRequestCreate) SetRule(v *AutoApprovalRule) *ChargeRequestCreate {
+	return _c.SetRuleID(v.ID)
+}
+
 // Mutation returns the ChargeRequestMutation object of the builder.
 func (_c *ChargeRequestCreate) Mutation() *ChargeRequestMutation {
 	return _c.mutation
@@ -90,6 +167,10 @@ func (_c *ChargeRequestCreate) defaults() {
 		v := chargerequest.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chargerequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -100,6 +181,9 @@ func (_c *ChargeRequestCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ChargeRequest.status"`)}
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChargeRequest.created_at"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ChargeRequest.user"`)}
 	}
@@ -137,6 +221,22 @@ func (_c *ChargeRequestCreate) createSpec() (*ChargeRequest, *sqlgraph.CreateSpe
 		_spec.SetField(chargerequest.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.DepositorName(); ok {
+		_spec.SetField(chargerequest.FieldDepositorName, field.TypeString, value)
+		_node.DepositorName = value
+	}
+	if value, ok := _c.mutation.PaymentMethod(); ok {
+		_spec.SetField(chargerequest.FieldPaymentMethod, field.TypeString, value)
+		_node.PaymentMethod = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chargerequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DecidedAt(); ok {
+		_spec.SetField(chargerequest.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -154,6 +254,23 @@ func (_c *ChargeRequestCreate) createSpec() (*ChargeRequest, *sqlgraph.CreateSpe
 		_node.charge_request_user = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargerequest.RuleTable,
+			Columns: []string{chargerequest.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.auto_approval_rule_charge_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
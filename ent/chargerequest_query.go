@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"somapay-backend/ent/autoapprovalrule"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/predicate"
 	"somapay-backend/ent/user"
@@ -24,7 +25,9 @@ type ChargeRequestQuery struct {
 	inters     []Interceptor
 	predicates []predicate.ChargeRequest
 	withUser   *UserQuery
+	withRule   *AutoApprovalRuleQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +86,28 @@ func (_q *ChargeRequestQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryRule chains the current query on the "rule" edge.
+func (_q *ChargeRequestQuery) QueryRule() *AutoApprovalRuleQuery {
+	query := (&AutoApprovalRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chargerequest.Table, chargerequest.FieldID, selector),
+			sqlgraph.To(autoapprovalrule.Table, autoapprovalrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chargerequest.RuleTable, chargerequest.RuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ChargeRequest entity from the query.
 // Returns a *NotFoundError when no ChargeRequest was found.
 func (_q *ChargeRequestQuery) First(ctx context.Context) (*ChargeRequest, error) {
@@ -276,9 +301,11 @@ func (_q *ChargeRequestQuery) Clone() *ChargeRequestQuery {
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.ChargeRequest{}, _q.predicates...),
 		withUser:   _q.withUser.Clone(),
+		withRule:   _q.withRule.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -293,6 +320,17 @@ func (_q *ChargeRequestQuery) WithUser(opts ...func(*UserQuery)) *ChargeRequestQ
 	return _q
 }
 
+// WithRule tells the query-builder to eager-load the nodes that are connected to
+// the "rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChargeRequestQuery) WithRule(opts ...func(*AutoApprovalRuleQuery)) *ChargeRequestQuery {
+	query := (&AutoApprovalRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRule = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,11 +410,12 @@ func (_q *ChargeRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*ChargeRequest{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUser != nil,
+			_q.withRule != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withUser != nil || _q.withRule != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -391,6 +430,9 @@ func (_q *ChargeRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -406,6 +448,12 @@ func (_q *ChargeRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withRule; query != nil {
+		if err := _q.loadRule(ctx, query, nodes, nil,
+			func(n *ChargeRequest, e *AutoApprovalRule) { n.Edges.Rule = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,9 +489,44 @@ func (_q *ChargeRequestQuery) loadUser(ctx context.Context, query *UserQuery, no
 	}
 	return nil
 }
+func (_q *ChargeRequestQuery) loadRule(ctx context.Context, query *AutoApprovalRuleQuery, nodes []*ChargeRequest, init func(*ChargeRequest), assign func(*ChargeRequest, *AutoApprovalRule)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ChargeRequest)
+	for i := range nodes {
+		if nodes[i].auto_approval_rule_charge_requests == nil {
+			continue
+		}
+		fk := *nodes[i].auto_approval_rule_charge_requests
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(autoapprovalrule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "auto_approval_rule_charge_requests" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *ChargeRequestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -506,6 +589,9 @@ func (_q *ChargeRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -523,6 +609,12 @@ func (_q *ChargeRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ChargeRequestQuery) Modify(modifiers ...func(s *sql.Selector)) *ChargeRequestSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ChargeRequestGroupBy is the group-by builder for ChargeRequest entities.
 type ChargeRequestGroupBy struct {
 	selector
@@ -612,3 +704,9 @@ func (_s *ChargeRequestSelect) sqlScan(ctx context.Context, root *ChargeRequestQ
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ChargeRequestSelect) Modify(modifiers ...func(s *sql.Selector)) *ChargeRequestSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
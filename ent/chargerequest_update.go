@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"somapay-backend/ent/autoapprovalrule"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/predicate"
 	"somapay-backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -18,8 +20,9 @@ import (
 // ChargeRequestUpdate is the builder for updating ChargeRequest entities.
 type ChargeRequestUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ChargeRequestMutation
+	hooks     []Hook
+	mutation  *ChargeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ChargeRequestUpdate builder.
@@ -63,6 +66,66 @@ func (_u *ChargeRequestUpdate) SetNillableStatus(v *string) *ChargeRequestUpdate
 	return _u
 }
 
+// SetDepositorName sets the "depositor_name" field.
+func (_u *ChargeRequestUpdate) SetDepositorName(v string) *ChargeRequestUpdate {
+	_u.mutation.SetDepositorName(v)
+	return _u
+}
+
+// SetNillableDepositorName sets the "depositor_name" field if the given value is not nil.
+func (_u *ChargeRequestUpdate) SetNillableDepositorName(v *string) *ChargeRequestUpdate {
+	if v != nil {
+		_u.SetDepositorName(*v)
+	}
+	return _u
+}
+
+// ClearDepositorName clears the value of the "depositor_name" field.
+func (_u *ChargeRequestUpdate) ClearDepositorName() *ChargeRequestUpdate {
+	_u.mutation.ClearDepositorName()
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *ChargeRequestUpdate) SetPaymentMethod(v string) *ChargeRequestUpdate {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *ChargeRequestUpdate) SetNillablePaymentMethod(v *string) *ChargeRequestUpdate {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (_u *ChargeRequestUpdate) ClearPaymentMethod() *ChargeRequestUpdate {
+	_u.mutation.ClearPaymentMethod()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ChargeRequestUpdate) SetDecidedAt(v time.Time) *ChargeRequestUpdate {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ChargeRequestUpdate) SetNillableDecidedAt(v *time.Time) *ChargeRequestUpdate {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ChargeRequestUpdate) ClearDecidedAt() *ChargeRequestUpdate {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *ChargeRequestUpdate) SetUserID(id int) *ChargeRequestUpdate {
 	_u.mutation.SetUserID(id)
@@ -74,6 +137,25 @@ func (_u *ChargeRequestUpdate) SetUser(v *User) *ChargeRequestUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetRuleID sets the "rule" edge to the AutoApprovalRule entity by ID.
+func (_u *ChargeRequestUpdate) SetRuleID(id int) *ChargeRequestUpdate {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule" edge to the AutoApprovalRule entity by ID if the given value is not nil.
+func (_u *ChargeRequestUpdate) SetNillableRuleID(id *int) *ChargeRequestUpdate {
+	if id != nil {
+		_u = _u.SetRuleID(*id)
+	}
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AutoApprovalRule entity.
+func (_u *ChargeRequestUpdate) SetRule(v *AutoApprovalRule) *ChargeRequestUpdate {
+	return _u.SetRuleID(v.ID)
+}
+
 // Mutation returns the ChargeRequestMutation object of the builder.
 func (_u *ChargeRequestUpdate) Mutation() *ChargeRequestMutation {
 	return _u.mutation
@@ -85,6 +167,12 @@ func (_u *ChargeRequestUpdate) ClearUser() *ChargeRequestUpdate {
 	return _u
 }
 
+// ClearRule clears the "rule" edge to the AutoApprovalRule entity.
+func (_u *ChargeRequestUpdate) ClearRule() *ChargeRequestUpdate {
+	_u.mutation.ClearRule()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChargeRequestUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -120,6 +208,12 @@ func (_u *ChargeRequestUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChargeRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChargeRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChargeRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -141,6 +235,24 @@ func (_u *ChargeRequestUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(chargerequest.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DepositorName(); ok {
+		_spec.SetField(chargerequest.FieldDepositorName, field.TypeString, value)
+	}
+	if _u.mutation.DepositorNameCleared() {
+		_spec.ClearField(chargerequest.FieldDepositorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(chargerequest.FieldPaymentMethod, field.TypeString, value)
+	}
+	if _u.mutation.PaymentMethodCleared() {
+		_spec.ClearField(chargerequest.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(chargerequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(chargerequest.FieldDecidedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -170,6 +282,36 @@ func (_u *ChargeRequestUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargerequest.RuleTable,
+			Columns: []string{chargerequest.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargerequest.RuleTable,
+			Columns: []string{chargerequest.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chargerequest.Label}
@@ -185,9 +327,10 @@ func (_u *ChargeRequestUpdate) sqlSave(ctx context.Context) (_node int, err erro
 // ChargeRequestUpdateOne is the builder for updating a single ChargeRequest entity.
 type ChargeRequestUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ChargeRequestMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ChargeRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAmount sets the "amount" field.
@@ -225,6 +368,66 @@ func (_u *ChargeRequestUpdateOne) SetNillableStatus(v *string) *ChargeRequestUpd
 	return _u
 }
 
+// SetDepositorName sets the "depositor_name" field.
+func (_u *ChargeRequestUpdateOne) SetDepositorName(v string) *ChargeRequestUpdateOne {
+	_u.mutation.SetDepositorName(v)
+	return _u
+}
+
+// SetNillableDepositorName sets the "depositor_name" field if the given value is not nil.
+func (_u *ChargeRequestUpdateOne) SetNillableDepositorName(v *string) *ChargeRequestUpdateOne {
+	if v != nil {
+		_u.SetDepositorName(*v)
+	}
+	return _u
+}
+
+// ClearDepositorName clears the value of the "depositor_name" field.
+func (_u *ChargeRequestUpdateOne) ClearDepositorName() *ChargeRequestUpdateOne {
+	_u.mutation.ClearDepositorName()
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *ChargeRequestUpdateOne) SetPaymentMethod(v string) *ChargeRequestUpdateOne {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *ChargeRequestUpdateOne) SetNillablePaymentMethod(v *string) *ChargeRequestUpdateOne {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (_u *ChargeRequestUpdateOne) ClearPaymentMethod() *ChargeRequestUpdateOne {
+	_u.mutation.ClearPaymentMethod()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ChargeRequestUpdateOne) SetDecidedAt(v time.Time) *ChargeRequestUpdateOne {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ChargeRequestUpdateOne) SetNillableDecidedAt(v *time.Time) *ChargeRequestUpdateOne {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ChargeRequestUpdateOne) ClearDecidedAt() *ChargeRequestUpdateOne {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *ChargeRequestUpdateOne) SetUserID(id int) *ChargeRequestUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -236,6 +439,25 @@ func (_u *ChargeRequestUpdateOne) SetUser(v *User) *ChargeRequestUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetRuleID sets the "rule" edge to the AutoApprovalRule entity by ID.
+func (_u *ChargeRequestUpdateOne) SetRuleID(id int) *ChargeRequestUpdateOne {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule" edge to the AutoApprovalRule entity by ID if the given value is not nil.
+func (_u *ChargeRequestUpdateOne) SetNillableRuleID(id *int) *ChargeRequestUpdateOne {
+	if id != nil {
+		_u = _u.SetRuleID(*id)
+	}
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AutoApprovalRule entity.
+func (_u *ChargeRequestUpdateOne) SetRule(v *AutoApprovalRule) *ChargeRequestUpdateOne {
+	return _u.SetRuleID(v.ID)
+}
+
 // Mutation returns the ChargeRequestMutation object of the builder.
 func (_u *ChargeRequestUpdateOne) Mutation() *ChargeRequestMutation {
 	return _u.mutation
@@ -247,6 +469,12 @@ func (_u *ChargeRequestUpdateOne) ClearUser() *ChargeRequestUpdateOne {
 	return _u
 }
 
+// ClearRule clears the "rule" edge to the AutoApprovalRule entity.
+func (_u *ChargeRequestUpdateOne) ClearRule() *ChargeRequestUpdateOne {
+	_u.mutation.ClearRule()
+	return _u
+}
+
 // Where appends a list predicates to the ChargeRequestUpdate builder.
 func (_u *ChargeRequestUpdateOne) Where(ps ...predicate.ChargeRequest) *ChargeRequestUpdateOne {
 	_u.mutation.Where(ps...)
@@ -295,6 +523,12 @@ func (_u *ChargeRequestUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChargeRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChargeRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ChargeRequestUpdateOne) sqlSave(ctx context.Context) (_node *ChargeRequest, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -333,6 +567,24 @@ func (_u *ChargeRequestUpdateOne) sqlSave(ctx context.Context) (_node *ChargeReq
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(chargerequest.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DepositorName(); ok {
+		_spec.SetField(chargerequest.FieldDepositorName, field.TypeString, value)
+	}
+	if _u.mutation.DepositorNameCleared() {
+		_spec.ClearField(chargerequest.FieldDepositorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(chargerequest.FieldPaymentMethod, field.TypeString, value)
+	}
+	if _u.mutation.PaymentMethodCleared() {
+		_spec.ClearField(chargerequest.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(chargerequest.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(chargerequest.FieldDecidedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -362,6 +614,36 @@ func (_u *ChargeRequestUpdateOne) sqlSave(ctx context.Context) (_node *ChargeReq
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargerequest.RuleTable,
+			Columns: []string{chargerequest.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chargerequest.RuleTable,
+			Columns: []string{chargerequest.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(autoapprovalrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ChargeRequest{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,11 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/auditlog"
+	"somapay-backend/ent/autoapprovalrule"
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/outboxevent"
 	"somapay-backend/ent/product"
 	"somapay-backend/ent/transaction"
 	"somapay-backend/ent/user"
+	"somapay-backend/ent/webhook"
+	"somapay-backend/ent/webhookdelivery"
 	"sync"
 
 	"entgo.io/ent"
@@ -77,11 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			booth.Table:         booth.ValidColumn,
-			chargerequest.Table: chargerequest.ValidColumn,
-			product.Table:       product.ValidColumn,
-			transaction.Table:   transaction.ValidColumn,
-			user.Table:          user.ValidColumn,
+			adjustment.Table:       adjustment.ValidColumn,
+			auditlog.Table:         auditlog.ValidColumn,
+			autoapprovalrule.Table: autoapprovalrule.ValidColumn,
+			booth.Table:            booth.ValidColumn,
+			chargerequest.Table:    chargerequest.ValidColumn,
+			outboxevent.Table:      outboxevent.ValidColumn,
+			product.Table:          product.ValidColumn,
+			transaction.Table:      transaction.ValidColumn,
+			user.Table:             user.ValidColumn,
+			webhook.Table:          webhook.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
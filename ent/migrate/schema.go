@@ -8,10 +8,92 @@ import (
 )
 
 var (
+	// AdjustmentsColumns holds the columns for the "adjustments" table.
+	AdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "user_adjustments", Type: field.TypeInt},
+		{Name: "user_issued_adjustments", Type: field.TypeInt},
+	}
+	// AdjustmentsTable holds the schema information for the "adjustments" table.
+	AdjustmentsTable = &schema.Table{
+		Name:       "adjustments",
+		Columns:    AdjustmentsColumns,
+		PrimaryKey: []*schema.Column{AdjustmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "adjustments_users_adjustments",
+				Columns:    []*schema.Column{AdjustmentsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "adjustments_users_issued_adjustments",
+				Columns:    []*schema.Column{AdjustmentsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_username", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "timestamp", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[8]},
+			},
+		},
+	}
+	// AutoApprovalRulesColumns holds the columns for the "auto_approval_rules" table.
+	AutoApprovalRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "max_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "role", Type: field.TypeString, Nullable: true},
+		{Name: "max_daily_approvals", Type: field.TypeInt, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AutoApprovalRulesTable holds the schema information for the "auto_approval_rules" table.
+	AutoApprovalRulesTable = &schema.Table{
+		Name:       "auto_approval_rules",
+		Columns:    AutoApprovalRulesColumns,
+		PrimaryKey: []*schema.Column{AutoApprovalRulesColumns[0]},
+	}
 	// BoothsColumns holds the columns for the "booths" table.
 	BoothsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "order_seq", Type: field.TypeInt, Default: 0},
 		{Name: "user_booth", Type: field.TypeInt, Unique: true},
 	}
 	// BoothsTable holds the schema information for the "booths" table.
@@ -22,7 +104,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "booths_users_booth",
-				Columns:    []*schema.Column{BoothsColumns[2]},
+				Columns:    []*schema.Column{BoothsColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -33,6 +115,11 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "amount", Type: field.TypeInt64},
 		{Name: "status", Type: field.TypeString, Default: "PENDING"},
+		{Name: "depositor_name", Type: field.TypeString, Nullable: true},
+		{Name: "payment_method", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_approval_rule_charge_requests", Type: field.TypeInt, Nullable: true},
 		{Name: "charge_request_user", Type: field.TypeInt},
 		{Name: "user_charge_requests", Type: field.TypeInt, Nullable: true},
 	}
@@ -42,19 +129,58 @@ var (
 		Columns:    ChargeRequestsColumns,
 		PrimaryKey: []*schema.Column{ChargeRequestsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "charge_requests_auto_approval_rules_charge_requests",
+				Columns:    []*schema.Column{ChargeRequestsColumns[7]},
+				RefColumns: []*schema.Column{AutoApprovalRulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "charge_requests_users_user",
-				Columns:    []*schema.Column{ChargeRequestsColumns[3]},
+				Columns:    []*schema.Column{ChargeRequestsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "charge_requests_users_charge_requests",
-				Columns:    []*schema.Column{ChargeRequestsColumns[4]},
+				Columns:    []*schema.Column{ChargeRequestsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chargerequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{ChargeRequestsColumns[2]},
+			},
+			{
+				Name:    "chargerequest_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChargeRequestsColumns[5]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "dispatched", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_dispatched",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[3]},
+			},
+		},
 	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
@@ -92,6 +218,9 @@ var (
 		{Name: "amount", Type: field.TypeInt64},
 		{Name: "status", Type: field.TypeString},
 		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "order_number", Type: field.TypeInt, Default: 0},
+		{Name: "fulfillment_status", Type: field.TypeString, Default: "RECEIVED"},
 		{Name: "booth_transactions", Type: field.TypeInt},
 		{Name: "product_transactions", Type: field.TypeInt},
 		{Name: "user_transactions", Type: field.TypeInt},
@@ -104,23 +233,40 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transactions_booths_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[5]},
+				Columns:    []*schema.Column{TransactionsColumns[8]},
 				RefColumns: []*schema.Column{BoothsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "transactions_products_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[6]},
+				Columns:    []*schema.Column{TransactionsColumns[9]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "transactions_users_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[7]},
+				Columns:    []*schema.Column{TransactionsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transaction_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[4]},
+			},
+			{
+				Name:    "transaction_booth_transactions_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[8], TransactionsColumns[4]},
+			},
+			{
+				Name:    "transaction_booth_transactions_fulfillment_status",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[8], TransactionsColumns[7]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -130,6 +276,10 @@ var (
 		{Name: "point", Type: field.TypeInt64},
 		{Name: "pin", Type: field.TypeString},
 		{Name: "role", Type: field.TypeString, Default: "USER"},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "grade", Type: field.TypeInt, Nullable: true},
+		{Name: "class", Type: field.TypeInt, Nullable: true},
+		{Name: "sessions_revoked_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -137,23 +287,89 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeString, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "outbox_event_deliveries", Type: field.TypeInt},
+		{Name: "webhook_deliveries", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_outbox_events_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[8]},
+				RefColumns: []*schema.Column{OutboxEventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AdjustmentsTable,
+		AuditLogsTable,
+		AutoApprovalRulesTable,
 		BoothsTable,
 		ChargeRequestsTable,
+		OutboxEventsTable,
 		ProductsTable,
 		TransactionsTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	AdjustmentsTable.ForeignKeys[0].RefTable = UsersTable
+	AdjustmentsTable.ForeignKeys[1].RefTable = UsersTable
 	BoothsTable.ForeignKeys[0].RefTable = UsersTable
-	ChargeRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	ChargeRequestsTable.ForeignKeys[0].RefTable = AutoApprovalRulesTable
 	ChargeRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	ChargeRequestsTable.ForeignKeys[2].RefTable = UsersTable
 	ProductsTable.ForeignKeys[0].RefTable = BoothsTable
 	ProductsTable.ForeignKeys[1].RefTable = BoothsTable
 	TransactionsTable.ForeignKeys[0].RefTable = BoothsTable
 	TransactionsTable.ForeignKeys[1].RefTable = ProductsTable
 	TransactionsTable.ForeignKeys[2].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = OutboxEventsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = WebhooksTable
 }
@@ -6,12 +6,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Adjustment is the predicate function for adjustment builders.
+type Adjustment func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// AutoApprovalRule is the predicate function for autoapprovalrule builders.
+type AutoApprovalRule func(*sql.Selector)
+
 // Booth is the predicate function for booth builders.
 type Booth func(*sql.Selector)
 
 // ChargeRequest is the predicate function for chargerequest builders.
 type ChargeRequest func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
@@ -20,3 +32,9 @@ type Transaction func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -3,10 +3,17 @@
 package ent
 
 import (
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/auditlog"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/booth"
 	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/outboxevent"
 	"somapay-backend/ent/schema"
 	"somapay-backend/ent/transaction"
 	"somapay-backend/ent/user"
+	"somapay-backend/ent/webhook"
+	"somapay-backend/ent/webhookdelivery"
 	"time"
 )
 
@@ -14,22 +21,100 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	adjustmentFields := schema.Adjustment{}.Fields()
+	_ = adjustmentFields
+	// adjustmentDescTimestamp is the schema descriptor for timestamp field.
+	adjustmentDescTimestamp := adjustmentFields[3].Descriptor()
+	// adjustment.DefaultTimestamp holds the default value on creation for the timestamp field.
+	adjustment.DefaultTimestamp = adjustmentDescTimestamp.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescTimestamp is the schema descriptor for timestamp field.
+	auditlogDescTimestamp := auditlogFields[7].Descriptor()
+	// auditlog.DefaultTimestamp holds the default value on creation for the timestamp field.
+	auditlog.DefaultTimestamp = auditlogDescTimestamp.Default.(func() time.Time)
+	autoapprovalruleFields := schema.AutoApprovalRule{}.Fields()
+	_ = autoapprovalruleFields
+	// autoapprovalruleDescEnabled is the schema descriptor for enabled field.
+	autoapprovalruleDescEnabled := autoapprovalruleFields[4].Descriptor()
+	// autoapprovalrule.DefaultEnabled holds the default value on creation for the enabled field.
+	autoapprovalrule.DefaultEnabled = autoapprovalruleDescEnabled.Default.(bool)
+	// autoapprovalruleDescCreatedAt is the schema descriptor for created_at field.
+	autoapprovalruleDescCreatedAt := autoapprovalruleFields[5].Descriptor()
+	// autoapprovalrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	autoapprovalrule.DefaultCreatedAt = autoapprovalruleDescCreatedAt.Default.(func() time.Time)
+	boothFields := schema.Booth{}.Fields()
+	_ = boothFields
+	// boothDescOrderSeq is the schema descriptor for order_seq field.
+	boothDescOrderSeq := boothFields[1].Descriptor()
+	// booth.DefaultOrderSeq holds the default value on creation for the order_seq field.
+	booth.DefaultOrderSeq = boothDescOrderSeq.Default.(int)
 	chargerequestFields := schema.ChargeRequest{}.Fields()
 	_ = chargerequestFields
 	// chargerequestDescStatus is the schema descriptor for status field.
 	chargerequestDescStatus := chargerequestFields[1].Descriptor()
 	// chargerequest.DefaultStatus holds the default value on creation for the status field.
 	chargerequest.DefaultStatus = chargerequestDescStatus.Default.(string)
+	// chargerequestDescCreatedAt is the schema descriptor for created_at field.
+	chargerequestDescCreatedAt := chargerequestFields[4].Descriptor()
+	// chargerequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chargerequest.DefaultCreatedAt = chargerequestDescCreatedAt.Default.(func() time.Time)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescDispatched is the schema descriptor for dispatched field.
+	outboxeventDescDispatched := outboxeventFields[2].Descriptor()
+	// outboxevent.DefaultDispatched holds the default value on creation for the dispatched field.
+	outboxevent.DefaultDispatched = outboxeventDescDispatched.Default.(bool)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[3].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
 	transactionFields := schema.Transaction{}.Fields()
 	_ = transactionFields
 	// transactionDescTimestamp is the schema descriptor for timestamp field.
 	transactionDescTimestamp := transactionFields[3].Descriptor()
 	// transaction.DefaultTimestamp holds the default value on creation for the timestamp field.
 	transaction.DefaultTimestamp = transactionDescTimestamp.Default.(func() time.Time)
+	// transactionDescOrderNumber is the schema descriptor for order_number field.
+	transactionDescOrderNumber := transactionFields[5].Descriptor()
+	// transaction.DefaultOrderNumber holds the default value on creation for the order_number field.
+	transaction.DefaultOrderNumber = transactionDescOrderNumber.Default.(int)
+	// transactionDescFulfillmentStatus is the schema descriptor for fulfillment_status field.
+	transactionDescFulfillmentStatus := transactionFields[6].Descriptor()
+	// transaction.DefaultFulfillmentStatus holds the default value on creation for the fulfillment_status field.
+	transaction.DefaultFulfillmentStatus = transactionDescFulfillmentStatus.Default.(string)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescRole is the schema descriptor for role field.
 	userDescRole := userFields[4].Descriptor()
 	// user.DefaultRole holds the default value on creation for the role field.
 	user.DefaultRole = userDescRole.Default.(string)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[3].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[4].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+type Adjustment struct {
+	ent.Schema
+}
+
+func (Adjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("amount"),
+		field.String("reason"), // CORRECTION / COMPENSATION / PRIZE / PENALTY
+		field.String("note").Optional(),
+		field.Time("timestamp").Default(time.Now),
+	}
+}
+
+func (Adjustment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("adjustments").
+			Unique().
+			Required(),
+
+		edge.From("admin", User.Type).
+			Ref("issued_adjustments").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// FieldChange 는 한 필드의 변경 전후 값. 생성이면 Old, 삭제면 New 가 비어 있다.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// AuditLog 는 주요 엔티티의 생성/수정/삭제 기록.
+// 행위자는 지워질 수도 있는 유저를 참조하지 않도록 id 와 이름을 그대로 남긴다.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type"),
+		field.Int("entity_id"),
+		field.String("action"), // CREATE / UPDATE / DELETE
+		field.Int("actor_id").Optional().Nillable(),
+		field.String("actor_username").Optional(),
+		field.String("ip").Optional(),
+		field.JSON("changes", map[string]FieldChange{}),
+		field.Time("timestamp").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("timestamp"),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// AutoApprovalRule 은 설정된 조건을 모두 만족하는 충전 요청을 생성 즉시 승인한다.
+type AutoApprovalRule struct {
+	ent.Schema
+}
+
+func (AutoApprovalRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int64("max_amount").Optional().Nillable(),
+		field.String("role").Optional(),
+		field.Int("max_daily_approvals").Optional().Nillable(),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AutoApprovalRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("charge_requests", ChargeRequest.Type),
+	}
+}
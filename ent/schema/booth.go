@@ -13,6 +13,8 @@ type Booth struct {
 func (Booth) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Unique(),
+		// 부스별 주문 번호를 매기기 위한 카운터
+		field.Int("order_seq").Default(0),
 	}
 }
 
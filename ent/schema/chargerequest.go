@@ -4,6 +4,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
 )
 
 type ChargeRequest struct {
@@ -14,6 +16,10 @@ func (ChargeRequest) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("amount"),
 		field.String("status").Default("PENDING"),
+		field.String("depositor_name").Optional(),
+		field.String("payment_method").Optional(), // CASH / BANK_TRANSFER
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("decided_at").Optional().Nillable(),
 	}
 }
 
@@ -22,5 +28,16 @@ func (ChargeRequest) Edges() []ent.Edge {
 		edge.To("user", User.Type).
 			Unique().
 			Required(),
+
+		edge.From("rule", AutoApprovalRule.Type).
+			Ref("charge_requests").
+			Unique(),
+	}
+}
+
+func (ChargeRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("created_at"),
 	}
 }
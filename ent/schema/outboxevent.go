@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// OutboxEvent 는 도메인 변경과 같은 트랜잭션에서 기록되는 외부 알림 이벤트.
+// 디스패처가 웹훅별 전송 건(WebhookDelivery)으로 펼친 뒤 dispatched 를 켠다.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type"),
+		field.Text("payload"),
+		field.Bool("dispatched").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (OutboxEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("dispatched"),
+	}
+}
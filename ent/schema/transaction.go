@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
@@ -17,6 +18,14 @@ func (Transaction) Fields() []ent.Field {
 		field.Int64("amount"),
 		field.String("status"),
 		field.Time("timestamp").Default(time.Now),
+		field.Time("refunded_at").Optional().Nillable(),
+		field.Int("order_number").Default(0),
+		field.String("fulfillment_status").Default("RECEIVED"), // RECEIVED / PREPARING / READY / PICKED_UP
+
+		// 엣지 외래키를 필드로 노출해 조인 없이 필터링한다
+		field.Int("user_id").StorageKey("user_transactions").Immutable(),
+		field.Int("booth_id").StorageKey("booth_transactions").Immutable(),
+		field.Int("product_id").StorageKey("product_transactions").Immutable(),
 	}
 }
 
@@ -24,17 +33,31 @@ func (Transaction) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
 			Ref("transactions").
+			Field("user_id").
 			Unique().
-			Required(),
+			Required().
+			Immutable(),
 
 		edge.From("booth", Booth.Type).
 			Ref("transactions").
+			Field("booth_id").
 			Unique().
-			Required(),
+			Required().
+			Immutable(),
 
 		edge.From("product", Product.Type).
 			Ref("transactions").
+			Field("product_id").
 			Unique().
-			Required(),
+			Required().
+			Immutable(),
+	}
+}
+
+func (Transaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("timestamp"),
+		index.Fields("booth_id", "timestamp"),
+		index.Fields("booth_id", "fulfillment_status"),
 	}
 }
@@ -13,10 +13,16 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("username").Unique(),
-		field.String("password"),
+		field.String("password").Sensitive(),
 		field.Int64("point"),
-		field.String("pin"),
+		field.String("pin").Sensitive(),
 		field.String("role").Default("USER"),
+		// 명단으로 일괄 등록한 학생 정보. 직접 만든 계정에는 비어 있다.
+		field.String("name").Optional(),
+		field.Int("grade").Optional(),
+		field.Int("class").Optional(),
+		// 이 시각 이전에 발급된 세션은 거부한다. 세션이 서버 메모리에 있어 다른 프로세스(CLI)에서 끊을 때 쓴다.
+		field.Time("sessions_revoked_at").Optional().Nillable(),
 	}
 }
 
@@ -25,5 +31,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("booth", Booth.Type).Unique(),
 		edge.To("transactions", Transaction.Type),
 		edge.To("charge_requests", ChargeRequest.Type),
+		edge.To("adjustments", Adjustment.Type),
+		edge.To("issued_adjustments", Adjustment.Type),
 	}
 }
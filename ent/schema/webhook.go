@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Webhook 은 관리자가 등록한 외부 수신 주소. events 가 비어 있으면 모든 이벤트를 받는다.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url"),
+		field.String("secret").Sensitive(),
+		field.Strings("events").Optional(),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WebhookDelivery 는 이벤트 하나를 웹훅 하나로 보내는 전송 건.
+// 재시도 한도를 넘기면 DEAD 가 되어 관리자가 확인하고 다시 보낼 수 있다.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status").Default("PENDING"), // PENDING / DELIVERED / DEAD
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Int("last_status_code").Optional().Nillable(),
+		field.String("last_error").Optional(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", OutboxEvent.Type).
+			Ref("deliveries").
+			Unique().
+			Required(),
+
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
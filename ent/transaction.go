@@ -28,13 +28,22 @@ type Transaction struct {
 	Status string `json:"status,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
 	Timestamp time.Time `json:"timestamp,omitempty"`
+	// RefundedAt holds the value of the "refunded_at" field.
+	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// OrderNumber holds the value of the "order_number" field.
+	OrderNumber int `json:"order_number,omitempty"`
+	// FulfillmentStatus holds the value of the "fulfillment_status" field.
+	FulfillmentStatus string `json:"fulfillment_status,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// BoothID holds the value of the "booth_id" field.
+	BoothID int `json:"booth_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID int `json:"product_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransactionQuery when eager-loading is set.
-	Edges                TransactionEdges `json:"edges"`
-	booth_transactions   *int
-	product_transactions *int
-	user_transactions    *int
-	selectValues         sql.SelectValues
+	Edges        TransactionEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // TransactionEdges holds the relations/edges for other nodes in the graph.
@@ -88,18 +97,12 @@ func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldID, transaction.FieldQuantity, transaction.FieldAmount:
+		case transaction.FieldID, transaction.FieldQuantity, transaction.FieldAmount, transaction.FieldOrderNumber, transaction.FieldUserID, transaction.FieldBoothID, transaction.FieldProductID:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldStatus:
+		case transaction.FieldStatus, transaction.FieldFulfillmentStatus:
 			values[i] = new(sql.NullString)
-		case transaction.FieldTimestamp:
+		case transaction.FieldTimestamp, transaction.FieldRefundedAt:
 			values[i] = new(sql.NullTime)
-		case transaction.ForeignKeys[0]: // booth_transactions
-			values[i] = new(sql.NullInt64)
-		case transaction.ForeignKeys[1]: // product_transactions
-			values[i] = new(sql.NullInt64)
-		case transaction.ForeignKeys[2]: // user_transactions
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -145,26 +148,42 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Timestamp = value.Time
 			}
-		case transaction.ForeignKeys[0]:
+		case transaction.FieldRefundedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_at", values[i])
+			} else if value.Valid {
+				_m.RefundedAt = new(time.Time)
+				*_m.RefundedAt = value.Time
+			}
+		case transaction.FieldOrderNumber:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field booth_transactions", value)
+				return fmt.Errorf("unexpected type %T for field order_number", values[i])
+			} else if value.Valid {
+				_m.OrderNumber = int(value.Int64)
+			}
+		case transaction.FieldFulfillmentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfillment_status", values[i])
 			} else if value.Valid {
-				_m.booth_transactions = new(int)
-				*_m.booth_transactions = int(value.Int64)
+				_m.FulfillmentStatus = value.String
 			}
-		case transaction.ForeignKeys[1]:
+		case transaction.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field product_transactions", value)
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
 			} else if value.Valid {
-				_m.product_transactions = new(int)
-				*_m.product_transactions = int(value.Int64)
+				_m.UserID = int(value.Int64)
 			}
-		case transaction.ForeignKeys[2]:
+		case transaction.FieldBoothID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_transactions", value)
+				return fmt.Errorf("unexpected type %T for field booth_id", values[i])
 			} else if value.Valid {
-				_m.user_transactions = new(int)
-				*_m.user_transactions = int(value.Int64)
+				_m.BoothID = int(value.Int64)
+			}
+		case transaction.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = int(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -228,6 +247,26 @@ func (_m *Transaction) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("timestamp=")
 	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RefundedAt; v != nil {
+		builder.WriteString("refunded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderNumber))
+	builder.WriteString(", ")
+	builder.WriteString("fulfillment_status=")
+	builder.WriteString(_m.FulfillmentStatus)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("booth_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BoothID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductID))
 	builder.WriteByte(')')
 	return builder.String()
 }
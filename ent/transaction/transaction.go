@@ -22,6 +22,18 @@ const (
 	FieldStatus = "status"
 	// FieldTimestamp holds the string denoting the timestamp field in the database.
 	FieldTimestamp = "timestamp"
+	// FieldRefundedAt holds the string denoting the refunded_at field in the database.
+	FieldRefundedAt = "refunded_at"
+	// FieldOrderNumber holds the string denoting the order_number field in the database.
+	FieldOrderNumber = "order_number"
+	// FieldFulfillmentStatus holds the string denoting the fulfillment_status field in the database.
+	FieldFulfillmentStatus = "fulfillment_status"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_transactions"
+	// FieldBoothID holds the string denoting the booth_id field in the database.
+	FieldBoothID = "booth_transactions"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_transactions"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeBooth holds the string denoting the booth edge name in mutations.
@@ -60,14 +72,12 @@ var Columns = []string{
 	FieldAmount,
 	FieldStatus,
 	FieldTimestamp,
-}
-
-// ForeignKeys holds the SQL foreign-keys that are owned by the "transactions"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"booth_transactions",
-	"product_transactions",
-	"user_transactions",
+	FieldRefundedAt,
+	FieldOrderNumber,
+	FieldFulfillmentStatus,
+	FieldUserID,
+	FieldBoothID,
+	FieldProductID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -77,17 +87,16 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
 var (
 	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
 	DefaultTimestamp func() time.Time
+	// DefaultOrderNumber holds the default value on creation for the "order_number" field.
+	DefaultOrderNumber int
+	// DefaultFulfillmentStatus holds the default value on creation for the "fulfillment_status" field.
+	DefaultFulfillmentStatus string
 )
 
 // OrderOption defines the ordering options for the Transaction queries.
@@ -118,6 +127,36 @@ func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
 }
 
+// ByRefundedAt orders the results by the refunded_at field.
+func ByRefundedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAt, opts...).ToFunc()
+}
+
+// ByOrderNumber orders the results by the order_number field.
+func ByOrderNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderNumber, opts...).ToFunc()
+}
+
+// ByFulfillmentStatus orders the results by the fulfillment_status field.
+func ByFulfillmentStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfillmentStatus, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBoothID orders the results by the booth_id field.
+func ByBoothID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoothID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
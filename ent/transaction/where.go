@@ -75,6 +75,36 @@ func Timestamp(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldTimestamp, v))
 }
 
+// RefundedAt applies equality check predicate on the "refunded_at" field. It's identical to RefundedAtEQ.
+func RefundedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// OrderNumber applies equality check predicate on the "order_number" field. It's identical to OrderNumberEQ.
+func OrderNumber(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOrderNumber, v))
+}
+
+// FulfillmentStatus applies equality check predicate on the "fulfillment_status" field. It's identical to FulfillmentStatusEQ.
+func FulfillmentStatus(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFulfillmentStatus, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// BoothID applies equality check predicate on the "booth_id" field. It's identical to BoothIDEQ.
+func BoothID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBoothID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldProductID, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldQuantity, v))
@@ -260,6 +290,221 @@ func TimestampLTE(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldTimestamp, v))
 }
 
+// RefundedAtEQ applies the EQ predicate on the "refunded_at" field.
+func RefundedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtNEQ applies the NEQ predicate on the "refunded_at" field.
+func RefundedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtIn applies the In predicate on the "refunded_at" field.
+func RefundedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtNotIn applies the NotIn predicate on the "refunded_at" field.
+func RefundedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtGT applies the GT predicate on the "refunded_at" field.
+func RefundedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldRefundedAt, v))
+}
+
+// RefundedAtGTE applies the GTE predicate on the "refunded_at" field.
+func RefundedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldRefundedAt, v))
+}
+
+// RefundedAtLT applies the LT predicate on the "refunded_at" field.
+func RefundedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldRefundedAt, v))
+}
+
+// RefundedAtLTE applies the LTE predicate on the "refunded_at" field.
+func RefundedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldRefundedAt, v))
+}
+
+// RefundedAtIsNil applies the IsNil predicate on the "refunded_at" field.
+func RefundedAtIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldRefundedAt))
+}
+
+// RefundedAtNotNil applies the NotNil predicate on the "refunded_at" field.
+func RefundedAtNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldRefundedAt))
+}
+
+// OrderNumberEQ applies the EQ predicate on the "order_number" field.
+func OrderNumberEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOrderNumber, v))
+}
+
+// OrderNumberNEQ applies the NEQ predicate on the "order_number" field.
+func OrderNumberNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldOrderNumber, v))
+}
+
+// OrderNumberIn applies the In predicate on the "order_number" field.
+func OrderNumberIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldOrderNumber, vs...))
+}
+
+// OrderNumberNotIn applies the NotIn predicate on the "order_number" field.
+func OrderNumberNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldOrderNumber, vs...))
+}
+
+// OrderNumberGT applies the GT predicate on the "order_number" field.
+func OrderNumberGT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldOrderNumber, v))
+}
+
+// OrderNumberGTE applies the GTE predicate on the "order_number" field.
+func OrderNumberGTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldOrderNumber, v))
+}
+
+// OrderNumberLT applies the LT predicate on the "order_number" field.
+func OrderNumberLT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldOrderNumber, v))
+}
+
+// OrderNumberLTE applies the LTE predicate on the "order_number" field.
+func OrderNumberLTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldOrderNumber, v))
+}
+
+// FulfillmentStatusEQ applies the EQ predicate on the "fulfillment_status" field.
+func FulfillmentStatusEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusNEQ applies the NEQ predicate on the "fulfillment_status" field.
+func FulfillmentStatusNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusIn applies the In predicate on the "fulfillment_status" field.
+func FulfillmentStatusIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldFulfillmentStatus, vs...))
+}
+
+// FulfillmentStatusNotIn applies the NotIn predicate on the "fulfillment_status" field.
+func FulfillmentStatusNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldFulfillmentStatus, vs...))
+}
+
+// FulfillmentStatusGT applies the GT predicate on the "fulfillment_status" field.
+func FulfillmentStatusGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusGTE applies the GTE predicate on the "fulfillment_status" field.
+func FulfillmentStatusGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusLT applies the LT predicate on the "fulfillment_status" field.
+func FulfillmentStatusLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusLTE applies the LTE predicate on the "fulfillment_status" field.
+func FulfillmentStatusLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusContains applies the Contains predicate on the "fulfillment_status" field.
+func FulfillmentStatusContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusHasPrefix applies the HasPrefix predicate on the "fulfillment_status" field.
+func FulfillmentStatusHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusHasSuffix applies the HasSuffix predicate on the "fulfillment_status" field.
+func FulfillmentStatusHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusEqualFold applies the EqualFold predicate on the "fulfillment_status" field.
+func FulfillmentStatusEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldFulfillmentStatus, v))
+}
+
+// FulfillmentStatusContainsFold applies the ContainsFold predicate on the "fulfillment_status" field.
+func FulfillmentStatusContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldFulfillmentStatus, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// BoothIDEQ applies the EQ predicate on the "booth_id" field.
+func BoothIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBoothID, v))
+}
+
+// BoothIDNEQ applies the NEQ predicate on the "booth_id" field.
+func BoothIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldBoothID, v))
+}
+
+// BoothIDIn applies the In predicate on the "booth_id" field.
+func BoothIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldBoothID, vs...))
+}
+
+// BoothIDNotIn applies the NotIn predicate on the "booth_id" field.
+func BoothIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldBoothID, vs...))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldProductID, vs...))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Transaction {
 	return predicate.Transaction(func(s *sql.Selector) {
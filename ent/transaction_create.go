@@ -55,34 +55,76 @@ func (_c *TransactionCreate) SetNillableTimestamp(v *time.Time) *TransactionCrea
 	return _c
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_c *TransactionCreate) SetUserID(id int) *TransactionCreate {
-	_c.mutation.SetUserID(id)
+// SetRefundedAt sets the "refunded_at" field.
+func (_c *TransactionCreate) SetRefundedAt(v time.Time) *TransactionCreate {
+	_c.mutation.SetRefundedAt(v)
 	return _c
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_c *TransactionCreate) SetUser(v *User) *TransactionCreate {
-	return _c.SetUserID(v.ID)
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableRefundedAt(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetRefundedAt(*v)
+	}
+	return _c
 }
 
-// SetBoothID sets the "booth" edge to the Booth entity by ID.
-func (_c *TransactionCreate) SetBoothID(id int) *TransactionCreate {
-	_c.mutation.SetBoothID(id)
+// SetOrderNumber sets the "order_number" field.
+func (_c *TransactionCreate) SetOrderNumber(v int) *TransactionCreate {
+	_c.mutation.SetOrderNumber(v)
 	return _c
 }
 
-// SetBooth sets the "booth" edge to the Booth entity.
-func (_c *TransactionCreate) SetBooth(v *Booth) *TransactionCreate {
-	return _c.SetBoothID(v.ID)
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableOrderNumber(v *int) *TransactionCreate {
+	if v != nil {
+		_c.SetOrderNumber(*v)
+	}
+	return _c
+}
+
+// SetFulfillmentStatus sets the "fulfillment_status" field.
+func (_c *TransactionCreate) SetFulfillmentStatus(v string) *TransactionCreate {
+	_c.mutation.SetFulfillmentStatus(v)
+	return _c
+}
+
+// SetNillableFulfillmentStatus sets the "fulfillment_status" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableFulfillmentStatus(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetFulfillmentStatus(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TransactionCreate) SetUserID(v int) *TransactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (_c *TransactionCreate) SetProductID(id int) *TransactionCreate {
-	_c.mutation.SetProductID(id)
+// SetBoothID sets the "booth_id" field.
+func (_c *TransactionCreate) SetBoothID(v int) *TransactionCreate {
+	_c.mutation.SetBoothID(v)
 	return _c
 }
 
+// SetProductID sets the "product_id" field.
+func (_c *TransactionCreate) SetProductID(v int) *TransactionCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TransactionCreate) SetUser(v *User) *TransactionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetBooth sets the "booth" edge to the Booth entity.
+func (_c *TransactionCreate) SetBooth(v *Booth) *TransactionCreate {
+	return _c.SetBoothID(v.ID)
+}
+
 // SetProduct sets the "product" edge to the Product entity.
 func (_c *TransactionCreate) SetProduct(v *Product) *TransactionCreate {
 	return _c.SetProductID(v.ID)
@@ -127,6 +169,14 @@ func (_c *TransactionCreate) defaults() {
 		v := transaction.DefaultTimestamp()
 		_c.mutation.SetTimestamp(v)
 	}
+	if _, ok := _c.mutation.OrderNumber(); !ok {
+		v := transaction.DefaultOrderNumber
+		_c.mutation.SetOrderNumber(v)
+	}
+	if _, ok := _c.mutation.FulfillmentStatus(); !ok {
+		v := transaction.DefaultFulfillmentStatus
+		_c.mutation.SetFulfillmentStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -143,6 +193,21 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.Timestamp(); !ok {
 		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Transaction.timestamp"`)}
 	}
+	if _, ok := _c.mutation.OrderNumber(); !ok {
+		return &ValidationError{Name: "order_number", err: errors.New(`ent: missing required field "Transaction.order_number"`)}
+	}
+	if _, ok := _c.mutation.FulfillmentStatus(); !ok {
+		return &ValidationError{Name: "fulfillment_status", err: errors.New(`ent: missing required field "Transaction.fulfillment_status"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Transaction.user_id"`)}
+	}
+	if _, ok := _c.mutation.BoothID(); !ok {
+		return &ValidationError{Name: "booth_id", err: errors.New(`ent: missing required field "Transaction.booth_id"`)}
+	}
+	if _, ok := _c.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "Transaction.product_id"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Transaction.user"`)}
 	}
@@ -194,6 +259,18 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
 	}
+	if value, ok := _c.mutation.RefundedAt(); ok {
+		_spec.SetField(transaction.FieldRefundedAt, field.TypeTime, value)
+		_node.RefundedAt = &value
+	}
+	if value, ok := _c.mutation.OrderNumber(); ok {
+		_spec.SetField(transaction.FieldOrderNumber, field.TypeInt, value)
+		_node.OrderNumber = value
+	}
+	if value, ok := _c.mutation.FulfillmentStatus(); ok {
+		_spec.SetField(transaction.FieldFulfillmentStatus, field.TypeString, value)
+		_node.FulfillmentStatus = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -208,7 +285,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_transactions = &nodes[0]
+		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.BoothIDs(); len(nodes) > 0 {
@@ -225,7 +302,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.booth_transactions = &nodes[0]
+		_node.BoothID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
@@ -242,7 +319,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.product_transactions = &nodes[0]
+		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -6,11 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"somapay-backend/ent/booth"
 	"somapay-backend/ent/predicate"
-	"somapay-backend/ent/product"
 	"somapay-backend/ent/transaction"
-	"somapay-backend/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,8 +18,9 @@ import (
 // TransactionUpdate is the builder for updating Transaction entities.
 type TransactionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TransactionMutation
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TransactionUpdate builder.
@@ -101,60 +99,64 @@ func (_u *TransactionUpdate) SetNillableTimestamp(v *time.Time) *TransactionUpda
 	return _u
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *TransactionUpdate) SetUserID(id int) *TransactionUpdate {
-	_u.mutation.SetUserID(id)
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *TransactionUpdate) SetRefundedAt(v time.Time) *TransactionUpdate {
+	_u.mutation.SetRefundedAt(v)
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *TransactionUpdate) SetUser(v *User) *TransactionUpdate {
-	return _u.SetUserID(v.ID)
-}
-
-// SetBoothID sets the "booth" edge to the Booth entity by ID.
-func (_u *TransactionUpdate) SetBoothID(id int) *TransactionUpdate {
-	_u.mutation.SetBoothID(id)
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableRefundedAt(v *time.Time) *TransactionUpdate {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
 	return _u
 }
 
-// SetBooth sets the "booth" edge to the Booth entity.
-func (_u *TransactionUpdate) SetBooth(v *Booth) *TransactionUpdate {
-	return _u.SetBoothID(v.ID)
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *TransactionUpdate) ClearRefundedAt() *TransactionUpdate {
+	_u.mutation.ClearRefundedAt()
+	return _u
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (_u *TransactionUpdate) SetProductID(id int) *TransactionUpdate {
-	_u.mutation.SetProductID(id)
+// SetOrderNumber sets the "order_number" field.
+func (_u *TransactionUpdate) SetOrderNumber(v int) *TransactionUpdate {
+	_u.mutation.ResetOrderNumber()
+	_u.mutation.SetOrderNumber(v)
 	return _u
 }
 
-// SetProduct sets the "product" edge to the Product entity.
-func (_u *TransactionUpdate) SetProduct(v *Product) *TransactionUpdate {
-	return _u.SetProductID(v.ID)
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableOrderNumber(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetOrderNumber(*v)
+	}
+	return _u
 }
 
-// Mutation returns the TransactionMutation object of the builder.
-func (_u *TransactionUpdate) Mutation() *TransactionMutation {
-	return _u.mutation
+// AddOrderNumber adds value to the "order_number" field.
+func (_u *TransactionUpdate) AddOrderNumber(v int) *TransactionUpdate {
+	_u.mutation.AddOrderNumber(v)
+	return _u
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *TransactionUpdate) ClearUser() *TransactionUpdate {
-	_u.mutation.ClearUser()
+// SetFulfillmentStatus sets the "fulfillment_status" field.
+func (_u *TransactionUpdate) SetFulfillmentStatus(v string) *TransactionUpdate {
+	_u.mutation.SetFulfillmentStatus(v)
 	return _u
 }
 
-// ClearBooth clears the "booth" edge to the Booth entity.
-func (_u *TransactionUpdate) ClearBooth() *TransactionUpdate {
-	_u.mutation.ClearBooth()
+// SetNillableFulfillmentStatus sets the "fulfillment_status" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableFulfillmentStatus(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetFulfillmentStatus(*v)
+	}
 	return _u
 }
 
-// ClearProduct clears the "product" edge to the Product entity.
-func (_u *TransactionUpdate) ClearProduct() *TransactionUpdate {
-	_u.mutation.ClearProduct()
-	return _u
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdate) Mutation() *TransactionMutation {
+	return _u.mutation
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -198,6 +200,12 @@ func (_u *TransactionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -228,93 +236,22 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Timestamp(); ok {
 		_spec.SetField(transaction.FieldTimestamp, field.TypeTime, value)
 	}
-	if _u.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _u.mutation.BoothCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.BoothTable,
-			Columns: []string{transaction.BoothColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(booth.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.BoothIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.BoothTable,
-			Columns: []string{transaction.BoothColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(booth.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _u.mutation.ProductCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.ProductTable,
-			Columns: []string{transaction.ProductColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.ProductTable,
-			Columns: []string{transaction.ProductColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(transaction.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(transaction.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OrderNumber(); ok {
+		_spec.SetField(transaction.FieldOrderNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderNumber(); ok {
+		_spec.AddField(transaction.FieldOrderNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FulfillmentStatus(); ok {
+		_spec.SetField(transaction.FieldFulfillmentStatus, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -330,9 +267,10 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // TransactionUpdateOne is the builder for updating a single Transaction entity.
 type TransactionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TransactionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetQuantity sets the "quantity" field.
@@ -405,60 +343,64 @@ func (_u *TransactionUpdateOne) SetNillableTimestamp(v *time.Time) *TransactionU
 	return _u
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *TransactionUpdateOne) SetUserID(id int) *TransactionUpdateOne {
-	_u.mutation.SetUserID(id)
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *TransactionUpdateOne) SetRefundedAt(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetRefundedAt(v)
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *TransactionUpdateOne) SetUser(v *User) *TransactionUpdateOne {
-	return _u.SetUserID(v.ID)
-}
-
-// SetBoothID sets the "booth" edge to the Booth entity by ID.
-func (_u *TransactionUpdateOne) SetBoothID(id int) *TransactionUpdateOne {
-	_u.mutation.SetBoothID(id)
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRefundedAt(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
 	return _u
 }
 
-// SetBooth sets the "booth" edge to the Booth entity.
-func (_u *TransactionUpdateOne) SetBooth(v *Booth) *TransactionUpdateOne {
-	return _u.SetBoothID(v.ID)
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *TransactionUpdateOne) ClearRefundedAt() *TransactionUpdateOne {
+	_u.mutation.ClearRefundedAt()
+	return _u
 }
 
-// SetProductID sets the "product" edge to the Product entity by ID.
-func (_u *TransactionUpdateOne) SetProductID(id int) *TransactionUpdateOne {
-	_u.mutation.SetProductID(id)
+// SetOrderNumber sets the "order_number" field.
+func (_u *TransactionUpdateOne) SetOrderNumber(v int) *TransactionUpdateOne {
+	_u.mutation.ResetOrderNumber()
+	_u.mutation.SetOrderNumber(v)
 	return _u
 }
 
-// SetProduct sets the "product" edge to the Product entity.
-func (_u *TransactionUpdateOne) SetProduct(v *Product) *TransactionUpdateOne {
-	return _u.SetProductID(v.ID)
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableOrderNumber(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetOrderNumber(*v)
+	}
+	return _u
 }
 
-// Mutation returns the TransactionMutation object of the builder.
-func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
-	return _u.mutation
+// AddOrderNumber adds value to the "order_number" field.
+func (_u *TransactionUpdateOne) AddOrderNumber(v int) *TransactionUpdateOne {
+	_u.mutation.AddOrderNumber(v)
+	return _u
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *TransactionUpdateOne) ClearUser() *TransactionUpdateOne {
-	_u.mutation.ClearUser()
+// SetFulfillmentStatus sets the "fulfillment_status" field.
+func (_u *TransactionUpdateOne) SetFulfillmentStatus(v string) *TransactionUpdateOne {
+	_u.mutation.SetFulfillmentStatus(v)
 	return _u
 }
 
-// ClearBooth clears the "booth" edge to the Booth entity.
-func (_u *TransactionUpdateOne) ClearBooth() *TransactionUpdateOne {
-	_u.mutation.ClearBooth()
+// SetNillableFulfillmentStatus sets the "fulfillment_status" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableFulfillmentStatus(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetFulfillmentStatus(*v)
+	}
 	return _u
 }
 
-// ClearProduct clears the "product" edge to the Product entity.
-func (_u *TransactionUpdateOne) ClearProduct() *TransactionUpdateOne {
-	_u.mutation.ClearProduct()
-	return _u
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
+	return _u.mutation
 }
 
 // Where appends a list predicates to the TransactionUpdate builder.
@@ -515,6 +457,12 @@ func (_u *TransactionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -562,93 +510,22 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.Timestamp(); ok {
 		_spec.SetField(transaction.FieldTimestamp, field.TypeTime, value)
 	}
-	if _u.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _u.mutation.BoothCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.BoothTable,
-			Columns: []string{transaction.BoothColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(booth.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.BoothIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.BoothTable,
-			Columns: []string{transaction.BoothColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(booth.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _u.mutation.ProductCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.ProductTable,
-			Columns: []string{transaction.ProductColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   transaction.ProductTable,
-			Columns: []string{transaction.ProductColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(transaction.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(transaction.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OrderNumber(); ok {
+		_spec.SetField(transaction.FieldOrderNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderNumber(); ok {
+		_spec.AddField(transaction.FieldOrderNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FulfillmentStatus(); ok {
+		_spec.SetField(transaction.FieldFulfillmentStatus, field.TypeString, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
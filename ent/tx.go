@@ -12,16 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Adjustment is the client for interacting with the Adjustment builders.
+	Adjustment *AdjustmentClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// AutoApprovalRule is the client for interacting with the AutoApprovalRule builders.
+	AutoApprovalRule *AutoApprovalRuleClient
 	// Booth is the client for interacting with the Booth builders.
 	Booth *BoothClient
 	// ChargeRequest is the client for interacting with the ChargeRequest builders.
 	ChargeRequest *ChargeRequestClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +165,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Adjustment = NewAdjustmentClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.AutoApprovalRule = NewAutoApprovalRuleClient(tx.config)
 	tx.Booth = NewBoothClient(tx.config)
 	tx.ChargeRequest = NewChargeRequestClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Booth.QueryXXX(), the query will be executed
+// applies a query, for example: Adjustment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -7,6 +7,7 @@ import (
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,13 +21,21 @@ type User struct {
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// Password holds the value of the "password" field.
-	Password string `json:"password,omitempty"`
+	Password string `json:"-"`
 	// Point holds the value of the "point" field.
 	Point int64 `json:"point,omitempty"`
 	// Pin holds the value of the "pin" field.
-	Pin string `json:"pin,omitempty"`
+	Pin string `json:"-"`
 	// Role holds the value of the "role" field.
 	Role string `json:"role,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Grade holds the value of the "grade" field.
+	Grade int `json:"grade,omitempty"`
+	// Class holds the value of the "class" field.
+	Class int `json:"class,omitempty"`
+	// SessionsRevokedAt holds the value of the "sessions_revoked_at" field.
+	SessionsRevokedAt *time.Time `json:"sessions_revoked_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -41,9 +50,13 @@ type UserEdges struct {
 	Transactions []*Transaction `json:"transactions,omitempty"`
 	// ChargeRequests holds the value of the charge_requests edge.
 	ChargeRequests []*ChargeRequest `json:"charge_requests,omitempty"`
+	// Adjustments holds the value of the adjustments edge.
+	Adjustments []*Adjustment `json:"adjustments,omitempty"`
+	// IssuedAdjustments holds the value of the issued_adjustments edge.
+	IssuedAdjustments []*Adjustment `json:"issued_adjustments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // BoothOrErr returns the Booth value or an error if the edge
@@ -75,15 +88,35 @@ func (e UserEdges) ChargeRequestsOrErr() ([]*ChargeRequest, error) {
 	return nil, &NotLoadedError{edge: "charge_requests"}
 }
 
+// AdjustmentsOrErr returns the Adjustments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AdjustmentsOrErr() ([]*Adjustment, error) {
+	if e.loadedTypes[3] {
+		return e.Adjustments, nil
+	}
+	return nil, &NotLoadedError{edge: "adjustments"}
+}
+
+// IssuedAdjustmentsOrErr returns the IssuedAdjustments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IssuedAdjustmentsOrErr() ([]*Adjustment, error) {
+	if e.loadedTypes[4] {
+		return e.IssuedAdjustments, nil
+	}
+	return nil, &NotLoadedError{edge: "issued_adjustments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldPoint:
+		case user.FieldID, user.FieldPoint, user.FieldGrade, user.FieldClass:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPassword, user.FieldPin, user.FieldRole:
+		case user.FieldUsername, user.FieldPassword, user.FieldPin, user.FieldRole, user.FieldName:
 			values[i] = new(sql.NullString)
+		case user.FieldSessionsRevokedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -135,6 +168,31 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Role = value.String
 			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case user.FieldGrade:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field grade", values[i])
+			} else if value.Valid {
+				_m.Grade = int(value.Int64)
+			}
+		case user.FieldClass:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field class", values[i])
+			} else if value.Valid {
+				_m.Class = int(value.Int64)
+			}
+		case user.FieldSessionsRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sessions_revoked_at", values[i])
+			} else if value.Valid {
+				_m.SessionsRevokedAt = new(time.Time)
+				*_m.SessionsRevokedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -163,6 +221,16 @@ func (_m *User) QueryChargeRequests() *ChargeRequestQuery {
 	return NewUserClient(_m.config).QueryChargeRequests(_m)
 }
 
+// QueryAdjustments queries the "adjustments" edge of the User entity.
+func (_m *User) QueryAdjustments() *AdjustmentQuery {
+	return NewUserClient(_m.config).QueryAdjustments(_m)
+}
+
+// QueryIssuedAdjustments queries the "issued_adjustments" edge of the User entity.
+func (_m *User) QueryIssuedAdjustments() *AdjustmentQuery {
+	return NewUserClient(_m.config).QueryIssuedAdjustments(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -189,17 +257,29 @@ func (_m *User) String() string {
 	builder.WriteString("username=")
 	builder.WriteString(_m.Username)
 	builder.WriteString(", ")
-	builder.WriteString("password=")
-	builder.WriteString(_m.Password)
+	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("point=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Point))
 	builder.WriteString(", ")
-	builder.WriteString("pin=")
-	builder.WriteString(_m.Pin)
+	builder.WriteString("pin=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("role=")
 	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("grade=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Grade))
+	builder.WriteString(", ")
+	builder.WriteString("class=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Class))
+	builder.WriteString(", ")
+	if v := _m.SessionsRevokedAt; v != nil {
+		builder.WriteString("sessions_revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,12 +22,24 @@ const (
 	FieldPin = "pin"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldGrade holds the string denoting the grade field in the database.
+	FieldGrade = "grade"
+	// FieldClass holds the string denoting the class field in the database.
+	FieldClass = "class"
+	// FieldSessionsRevokedAt holds the string denoting the sessions_revoked_at field in the database.
+	FieldSessionsRevokedAt = "sessions_revoked_at"
 	// EdgeBooth holds the string denoting the booth edge name in mutations.
 	EdgeBooth = "booth"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
 	// EdgeChargeRequests holds the string denoting the charge_requests edge name in mutations.
 	EdgeChargeRequests = "charge_requests"
+	// EdgeAdjustments holds the string denoting the adjustments edge name in mutations.
+	EdgeAdjustments = "adjustments"
+	// EdgeIssuedAdjustments holds the string denoting the issued_adjustments edge name in mutations.
+	EdgeIssuedAdjustments = "issued_adjustments"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// BoothTable is the table that holds the booth relation/edge.
@@ -51,6 +63,20 @@ const (
 	ChargeRequestsInverseTable = "charge_requests"
 	// ChargeRequestsColumn is the table column denoting the charge_requests relation/edge.
 	ChargeRequestsColumn = "user_charge_requests"
+	// AdjustmentsTable is the table that holds the adjustments relation/edge.
+	AdjustmentsTable = "adjustments"
+	// AdjustmentsInverseTable is the table name for the Adjustment entity.
+	// It exists in this package in order to avoid circular dependency with the "adjustment" package.
+	AdjustmentsInverseTable = "adjustments"
+	// AdjustmentsColumn is the table column denoting the adjustments relation/edge.
+	AdjustmentsColumn = "user_adjustments"
+	// IssuedAdjustmentsTable is the table that holds the issued_adjustments relation/edge.
+	IssuedAdjustmentsTable = "adjustments"
+	// IssuedAdjustmentsInverseTable is the table name for the Adjustment entity.
+	// It exists in this package in order to avoid circular dependency with the "adjustment" package.
+	IssuedAdjustmentsInverseTable = "adjustments"
+	// IssuedAdjustmentsColumn is the table column denoting the issued_adjustments relation/edge.
+	IssuedAdjustmentsColumn = "user_issued_adjustments"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -61,6 +87,10 @@ var Columns = []string{
 	FieldPoint,
 	FieldPin,
 	FieldRole,
+	FieldName,
+	FieldGrade,
+	FieldClass,
+	FieldSessionsRevokedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -111,6 +141,26 @@ func ByRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
 }
 
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByGrade orders the results by the grade field.
+func ByGrade(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrade, opts...).ToFunc()
+}
+
+// ByClass orders the results by the class field.
+func ByClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClass, opts...).ToFunc()
+}
+
+// BySessionsRevokedAt orders the results by the sessions_revoked_at field.
+func BySessionsRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionsRevokedAt, opts...).ToFunc()
+}
+
 // ByBoothField orders the results by booth field.
 func ByBoothField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -145,6 +195,34 @@ func ByChargeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChargeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAdjustmentsCount orders the results by adjustments count.
+func ByAdjustmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdjustmentsStep(), opts...)
+	}
+}
+
+// ByAdjustments orders the results by adjustments terms.
+func ByAdjustments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdjustmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIssuedAdjustmentsCount orders the results by issued_adjustments count.
+func ByIssuedAdjustmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIssuedAdjustmentsStep(), opts...)
+	}
+}
+
+// ByIssuedAdjustments orders the results by issued_adjustments terms.
+func ByIssuedAdjustments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIssuedAdjustmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBoothStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -166,3 +244,17 @@ func newChargeRequestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChargeRequestsTable, ChargeRequestsColumn),
 	)
 }
+func newAdjustmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdjustmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AdjustmentsTable, AdjustmentsColumn),
+	)
+}
+func newIssuedAdjustmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IssuedAdjustmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IssuedAdjustmentsTable, IssuedAdjustmentsColumn),
+	)
+}
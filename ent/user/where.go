@@ -4,6 +4,7 @@ package user
 
 import (
 	"somapay-backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -79,6 +80,26 @@ func Role(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldRole, v))
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldName, v))
+}
+
+// Grade applies equality check predicate on the "grade" field. It's identical to GradeEQ.
+func Grade(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGrade, v))
+}
+
+// Class applies equality check predicate on the "class" field. It's identical to ClassEQ.
+func Class(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldClass, v))
+}
+
+// SessionsRevokedAt applies equality check predicate on the "sessions_revoked_at" field. It's identical to SessionsRevokedAtEQ.
+func SessionsRevokedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionsRevokedAt, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
@@ -379,6 +400,231 @@ func RoleContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldRole, v))
 }
 
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldName, v))
+}
+
+// GradeEQ applies the EQ predicate on the "grade" field.
+func GradeEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGrade, v))
+}
+
+// GradeNEQ applies the NEQ predicate on the "grade" field.
+func GradeNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGrade, v))
+}
+
+// GradeIn applies the In predicate on the "grade" field.
+func GradeIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGrade, vs...))
+}
+
+// GradeNotIn applies the NotIn predicate on the "grade" field.
+func GradeNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGrade, vs...))
+}
+
+// GradeGT applies the GT predicate on the "grade" field.
+func GradeGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldGrade, v))
+}
+
+// GradeGTE applies the GTE predicate on the "grade" field.
+func GradeGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldGrade, v))
+}
+
+// GradeLT applies the LT predicate on the "grade" field.
+func GradeLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldGrade, v))
+}
+
+// GradeLTE applies the LTE predicate on the "grade" field.
+func GradeLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldGrade, v))
+}
+
+// GradeIsNil applies the IsNil predicate on the "grade" field.
+func GradeIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGrade))
+}
+
+// GradeNotNil applies the NotNil predicate on the "grade" field.
+func GradeNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGrade))
+}
+
+// ClassEQ applies the EQ predicate on the "class" field.
+func ClassEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldClass, v))
+}
+
+// ClassNEQ applies the NEQ predicate on the "class" field.
+func ClassNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldClass, v))
+}
+
+// ClassIn applies the In predicate on the "class" field.
+func ClassIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldClass, vs...))
+}
+
+// ClassNotIn applies the NotIn predicate on the "class" field.
+func ClassNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldClass, vs...))
+}
+
+// ClassGT applies the GT predicate on the "class" field.
+func ClassGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldClass, v))
+}
+
+// ClassGTE applies the GTE predicate on the "class" field.
+func ClassGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldClass, v))
+}
+
+// ClassLT applies the LT predicate on the "class" field.
+func ClassLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldClass, v))
+}
+
+// ClassLTE applies the LTE predicate on the "class" field.
+func ClassLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldClass, v))
+}
+
+// ClassIsNil applies the IsNil predicate on the "class" field.
+func ClassIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldClass))
+}
+
+// ClassNotNil applies the NotNil predicate on the "class" field.
+func ClassNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldClass))
+}
+
+// SessionsRevokedAtEQ applies the EQ predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtNEQ applies the NEQ predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtIn applies the In predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSessionsRevokedAt, vs...))
+}
+
+// SessionsRevokedAtNotIn applies the NotIn predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSessionsRevokedAt, vs...))
+}
+
+// SessionsRevokedAtGT applies the GT predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtGTE applies the GTE predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtLT applies the LT predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtLTE applies the LTE predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSessionsRevokedAt, v))
+}
+
+// SessionsRevokedAtIsNil applies the IsNil predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSessionsRevokedAt))
+}
+
+// SessionsRevokedAtNotNil applies the NotNil predicate on the "sessions_revoked_at" field.
+func SessionsRevokedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSessionsRevokedAt))
+}
+
 // HasBooth applies the HasEdge predicate on the "booth" edge.
 func HasBooth() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -448,6 +694,52 @@ func HasChargeRequestsWith(preds ...predicate.ChargeRequest) predicate.User {
 	})
 }
 
+// HasAdjustments applies the HasEdge predicate on the "adjustments" edge.
+func HasAdjustments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AdjustmentsTable, AdjustmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdjustmentsWith applies the HasEdge predicate on the "adjustments" edge with a given conditions (other predicates).
+func HasAdjustmentsWith(preds ...predicate.Adjustment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAdjustmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuedAdjustments applies the HasEdge predicate on the "issued_adjustments" edge.
+func HasIssuedAdjustments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IssuedAdjustmentsTable, IssuedAdjustmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuedAdjustmentsWith applies the HasEdge predicate on the "issued_adjustments" edge with a given conditions (other predicates).
+func HasIssuedAdjustmentsWith(preds ...predicate.Adjustment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIssuedAdjustmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -6,10 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"somapay-backend/ent/adjustment"
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/transaction"
 	"somapay-backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -60,6 +62,62 @@ func (_c *UserCreate) SetNillableRole(v *string) *UserCreate {
 	return _c
 }
 
+// SetName sets the "name" field.
+func (_c *UserCreate) SetName(v string) *UserCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *UserCreate) SetNillableName(v *string) *UserCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetGrade sets the "grade" field.
+func (_c *UserCreate) SetGrade(v int) *UserCreate {
+	_c.mutation.SetGrade(v)
+	return _c
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (_c *UserCreate) SetNillableGrade(v *int) *UserCreate {
+	if v != nil {
+		_c.SetGrade(*v)
+	}
+	return _c
+}
+
+// SetClass sets the "class" field.
+func (_c *UserCreate) SetClass(v int) *UserCreate {
+	_c.mutation.SetClass(v)
+	return _c
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_c *UserCreate) SetNillableClass(v *int) *UserCreate {
+	if v != nil {
+		_c.SetClass(*v)
+	}
+	return _c
+}
+
+// SetSessionsRevokedAt sets the "sessions_revoked_at" field.
+func (_c *UserCreate) SetSessionsRevokedAt(v time.Time) *UserCreate {
+	_c.mutation.SetSessionsRevokedAt(v)
+	return _c
+}
+
+// SetNillableSessionsRevokedAt sets the "sessions_revoked_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableSessionsRevokedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetSessionsRevokedAt(*v)
+	}
+	return _c
+}
+
 // SetBoothID sets the "booth" edge to the Booth entity by ID.
 func (_c *UserCreate) SetBoothID(id int) *UserCreate {
 	_c.mutation.SetBoothID(id)
@@ -109,6 +167,36 @@ func (_c *UserCreate) AddChargeRequests(v ...*ChargeRequest) *UserCreate {
 	return _c.AddChargeRequestIDs(ids...)
 }
 
+// AddAdjustmentIDs adds the "adjustments" edge to the Adjustment entity by IDs.
+func (_c *UserCreate) AddAdjustmentIDs(ids ...int) *UserCreate {
+	_c.mutation.AddAdjustmentIDs(ids...)
+	return _c
+}
+
+// AddAdjustments adds the "adjustments" edges to the Adjustment entity.
+func (_c *UserCreate) AddAdjustments(v ...*Adjustment) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAdjustmentIDs(ids...)
+}
+
+// AddIssuedAdjustmentIDs adds the "issued_adjustments" edge to the Adjustment entity by IDs.
+func (_c *UserCreate) AddIssuedAdjustmentIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIssuedAdjustmentIDs(ids...)
+	return _c
+}
+
+// AddIssuedAdjustments adds the "issued_adjustments" edges to the Adjustment entity.
+func (_c *UserCreate) AddIssuedAdjustments(v ...*Adjustment) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIssuedAdjustmentIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -213,6 +301,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 		_node.Role = value
 	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Grade(); ok {
+		_spec.SetField(user.FieldGrade, field.TypeInt, value)
+		_node.Grade = value
+	}
+	if value, ok := _c.mutation.Class(); ok {
+		_spec.SetField(user.FieldClass, field.TypeInt, value)
+		_node.Class = value
+	}
+	if value, ok := _c.mutation.SessionsRevokedAt(); ok {
+		_spec.SetField(user.FieldSessionsRevokedAt, field.TypeTime, value)
+		_node.SessionsRevokedAt = &value
+	}
 	if nodes := _c.mutation.BoothIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -261,6 +365,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IssuedAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"somapay-backend/ent/adjustment"
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/chargerequest"
 	"somapay-backend/ent/predicate"
 	"somapay-backend/ent/transaction"
 	"somapay-backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -20,8 +22,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -107,6 +110,100 @@ func (_u *UserUpdate) SetNillableRole(v *string) *UserUpdate {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *UserUpdate) SetName(v string) *UserUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableName(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *UserUpdate) ClearName() *UserUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetGrade sets the "grade" field.
+func (_u *UserUpdate) SetGrade(v int) *UserUpdate {
+	_u.mutation.ResetGrade()
+	_u.mutation.SetGrade(v)
+	return _u
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableGrade(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetGrade(*v)
+	}
+	return _u
+}
+
+// AddGrade adds value to the "grade" field.
+func (_u *UserUpdate) AddGrade(v int) *UserUpdate {
+	_u.mutation.AddGrade(v)
+	return _u
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (_u *UserUpdate) ClearGrade() *UserUpdate {
+	_u.mutation.ClearGrade()
+	return _u
+}
+
+// SetClass sets the "class" field.
+func (_u *UserUpdate) SetClass(v int) *UserUpdate {
+	_u.mutation.ResetClass()
+	_u.mutation.SetClass(v)
+	return _u
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableClass(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetClass(*v)
+	}
+	return _u
+}
+
+// AddClass adds value to the "class" field.
+func (_u *UserUpdate) AddClass(v int) *UserUpdate {
+	_u.mutation.AddClass(v)
+	return _u
+}
+
+// ClearClass clears the value of the "class" field.
+func (_u *UserUpdate) ClearClass() *UserUpdate {
+	_u.mutation.ClearClass()
+	return _u
+}
+
+// SetSessionsRevokedAt sets the "sessions_revoked_at" field.
+func (_u *UserUpdate) SetSessionsRevokedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetSessionsRevokedAt(v)
+	return _u
+}
+
+// SetNillableSessionsRevokedAt sets the "sessions_revoked_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableSessionsRevokedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetSessionsRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearSessionsRevokedAt clears the value of the "sessions_revoked_at" field.
+func (_u *UserUpdate) ClearSessionsRevokedAt() *UserUpdate {
+	_u.mutation.ClearSessionsRevokedAt()
+	return _u
+}
+
 // SetBoothID sets the "booth" edge to the Booth entity by ID.
 func (_u *UserUpdate) SetBoothID(id int) *UserUpdate {
 	_u.mutation.SetBoothID(id)
@@ -156,6 +253,36 @@ func (_u *UserUpdate) AddChargeRequests(v ...*ChargeRequest) *UserUpdate {
 	return _u.AddChargeRequestIDs(ids...)
 }
 
+// AddAdjustmentIDs adds the "adjustments" edge to the Adjustment entity by IDs.
+func (_u *UserUpdate) AddAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddAdjustments adds the "adjustments" edges to the Adjustment entity.
+func (_u *UserUpdate) AddAdjustments(v ...*Adjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdjustmentIDs(ids...)
+}
+
+// AddIssuedAdjustmentIDs adds the "issued_adjustments" edge to the Adjustment entity by IDs.
+func (_u *UserUpdate) AddIssuedAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddIssuedAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddIssuedAdjustments adds the "issued_adjustments" edges to the Adjustment entity.
+func (_u *UserUpdate) AddIssuedAdjustments(v ...*Adjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIssuedAdjustmentIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -209,6 +336,48 @@ func (_u *UserUpdate) RemoveChargeRequests(v ...*ChargeRequest) *UserUpdate {
 	return _u.RemoveChargeRequestIDs(ids...)
 }
 
+// ClearAdjustments clears all "adjustments" edges to the Adjustment entity.
+func (_u *UserUpdate) ClearAdjustments() *UserUpdate {
+	_u.mutation.ClearAdjustments()
+	return _u
+}
+
+// RemoveAdjustmentIDs removes the "adjustments" edge to Adjustment entities by IDs.
+func (_u *UserUpdate) RemoveAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveAdjustments removes "adjustments" edges to Adjustment entities.
+func (_u *UserUpdate) RemoveAdjustments(v ...*Adjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdjustmentIDs(ids...)
+}
+
+// ClearIssuedAdjustments clears all "issued_adjustments" edges to the Adjustment entity.
+func (_u *UserUpdate) ClearIssuedAdjustments() *UserUpdate {
+	_u.mutation.ClearIssuedAdjustments()
+	return _u
+}
+
+// RemoveIssuedAdjustmentIDs removes the "issued_adjustments" edge to Adjustment entities by IDs.
+func (_u *UserUpdate) RemoveIssuedAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveIssuedAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedAdjustments removes "issued_adjustments" edges to Adjustment entities.
+func (_u *UserUpdate) RemoveIssuedAdjustments(v ...*Adjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIssuedAdjustmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -236,6 +405,12 @@ func (_u *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -263,6 +438,36 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Grade(); ok {
+		_spec.SetField(user.FieldGrade, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGrade(); ok {
+		_spec.AddField(user.FieldGrade, field.TypeInt, value)
+	}
+	if _u.mutation.GradeCleared() {
+		_spec.ClearField(user.FieldGrade, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Class(); ok {
+		_spec.SetField(user.FieldClass, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClass(); ok {
+		_spec.AddField(user.FieldClass, field.TypeInt, value)
+	}
+	if _u.mutation.ClassCleared() {
+		_spec.ClearField(user.FieldClass, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SessionsRevokedAt(); ok {
+		_spec.SetField(user.FieldSessionsRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SessionsRevokedAtCleared() {
+		_spec.ClearField(user.FieldSessionsRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.BoothCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -382,6 +587,97 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IssuedAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIssuedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.IssuedAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IssuedAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -397,9 +693,10 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUsername sets the "username" field.
@@ -479,6 +776,100 @@ func (_u *UserUpdateOne) SetNillableRole(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *UserUpdateOne) SetName(v string) *UserUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *UserUpdateOne) ClearName() *UserUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetGrade sets the "grade" field.
+func (_u *UserUpdateOne) SetGrade(v int) *UserUpdateOne {
+	_u.mutation.ResetGrade()
+	_u.mutation.SetGrade(v)
+	return _u
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableGrade(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetGrade(*v)
+	}
+	return _u
+}
+
+// AddGrade adds value to the "grade" field.
+func (_u *UserUpdateOne) AddGrade(v int) *UserUpdateOne {
+	_u.mutation.AddGrade(v)
+	return _u
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (_u *UserUpdateOne) ClearGrade() *UserUpdateOne {
+	_u.mutation.ClearGrade()
+	return _u
+}
+
+// SetClass sets the "class" field.
+func (_u *UserUpdateOne) SetClass(v int) *UserUpdateOne {
+	_u.mutation.ResetClass()
+	_u.mutation.SetClass(v)
+	return _u
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableClass(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetClass(*v)
+	}
+	return _u
+}
+
+// AddClass adds value to the "class" field.
+func (_u *UserUpdateOne) AddClass(v int) *UserUpdateOne {
+	_u.mutation.AddClass(v)
+	return _u
+}
+
+// ClearClass clears the value of the "class" field.
+func (_u *UserUpdateOne) ClearClass() *UserUpdateOne {
+	_u.mutation.ClearClass()
+	return _u
+}
+
+// SetSessionsRevokedAt sets the "sessions_revoked_at" field.
+func (_u *UserUpdateOne) SetSessionsRevokedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetSessionsRevokedAt(v)
+	return _u
+}
+
+// SetNillableSessionsRevokedAt sets the "sessions_revoked_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSessionsRevokedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetSessionsRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearSessionsRevokedAt clears the value of the "sessions_revoked_at" field.
+func (_u *UserUpdateOne) ClearSessionsRevokedAt() *UserUpdateOne {
+	_u.mutation.ClearSessionsRevokedAt()
+	return _u
+}
+
 // SetBoothID sets the "booth" edge to the Booth entity by ID.
 func (_u *UserUpdateOne) SetBoothID(id int) *UserUpdateOne {
 	_u.mutation.SetBoothID(id)
@@ -528,6 +919,36 @@ func (_u *UserUpdateOne) AddChargeRequests(v ...*ChargeRequest) *UserUpdateOne {
 	return _u.AddChargeRequestIDs(ids...)
 }
 
+// AddAdjustmentIDs adds the "adjustments" edge to the Adjustment entity by IDs.
+func (_u *UserUpdateOne) AddAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddAdjustments adds the "adjustments" edges to the Adjustment entity.
+func (_u *UserUpdateOne) AddAdjustments(v ...*Adjustment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdjustmentIDs(ids...)
+}
+
+// AddIssuedAdjustmentIDs adds the "issued_adjustments" edge to the Adjustment entity by IDs.
+func (_u *UserUpdateOne) AddIssuedAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddIssuedAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddIssuedAdjustments adds the "issued_adjustments" edges to the Adjustment entity.
+func (_u *UserUpdateOne) AddIssuedAdjustments(v ...*Adjustment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIssuedAdjustmentIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -581,6 +1002,48 @@ func (_u *UserUpdateOne) RemoveChargeRequests(v ...*ChargeRequest) *UserUpdateOn
 	return _u.RemoveChargeRequestIDs(ids...)
 }
 
+// ClearAdjustments clears all "adjustments" edges to the Adjustment entity.
+func (_u *UserUpdateOne) ClearAdjustments() *UserUpdateOne {
+	_u.mutation.ClearAdjustments()
+	return _u
+}
+
+// RemoveAdjustmentIDs removes the "adjustments" edge to Adjustment entities by IDs.
+func (_u *UserUpdateOne) RemoveAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveAdjustments removes "adjustments" edges to Adjustment entities.
+func (_u *UserUpdateOne) RemoveAdjustments(v ...*Adjustment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdjustmentIDs(ids...)
+}
+
+// ClearIssuedAdjustments clears all "issued_adjustments" edges to the Adjustment entity.
+func (_u *UserUpdateOne) ClearIssuedAdjustments() *UserUpdateOne {
+	_u.mutation.ClearIssuedAdjustments()
+	return _u
+}
+
+// RemoveIssuedAdjustmentIDs removes the "issued_adjustments" edge to Adjustment entities by IDs.
+func (_u *UserUpdateOne) RemoveIssuedAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveIssuedAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedAdjustments removes "issued_adjustments" edges to Adjustment entities.
+func (_u *UserUpdateOne) RemoveIssuedAdjustments(v ...*Adjustment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIssuedAdjustmentIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -621,6 +1084,12 @@ func (_u *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -665,6 +1134,36 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Grade(); ok {
+		_spec.SetField(user.FieldGrade, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGrade(); ok {
+		_spec.AddField(user.FieldGrade, field.TypeInt, value)
+	}
+	if _u.mutation.GradeCleared() {
+		_spec.ClearField(user.FieldGrade, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Class(); ok {
+		_spec.SetField(user.FieldClass, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClass(); ok {
+		_spec.AddField(user.FieldClass, field.TypeInt, value)
+	}
+	if _u.mutation.ClassCleared() {
+		_spec.ClearField(user.FieldClass, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SessionsRevokedAt(); ok {
+		_spec.SetField(user.FieldSessionsRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SessionsRevokedAtCleared() {
+		_spec.ClearField(user.FieldSessionsRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.BoothCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -784,6 +1283,97 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AdjustmentsTable,
+			Columns: []string{user.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IssuedAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIssuedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.IssuedAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IssuedAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedAdjustmentsTable,
+			Columns: []string{user.IssuedAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
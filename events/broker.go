@@ -0,0 +1,131 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+const subscriberBuffer = 64
+
+type Event struct {
+	ID   uint64    `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	Data any       `json:"data"`
+
+	userIDs []int
+}
+
+func (e Event) deliverableTo(userID int) bool {
+	for _, id := range e.userIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type subscriber struct {
+	userID int
+	ch     chan Event
+}
+
+// Broker 는 핸들러가 발행한 이벤트를 구독 중인 연결에 나눠주는 프로세스 내 브로커다.
+// 최근 이벤트를 history 만큼 보관해 재접속한 클라이언트가 놓친 이벤트를 이어 받을 수 있게 한다.
+type Broker struct {
+	mu      sync.Mutex
+	nextID  uint64
+	history []Event
+	size    int
+	subs    map[*subscriber]struct{}
+	closed  bool
+}
+
+func NewBroker(historySize int) *Broker {
+	return &Broker{
+		size: historySize,
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish 는 userIDs 에게 이벤트를 보낸다. 중복된 id 는 한 번만 받는다.
+func (b *Broker) Publish(typ string, data any, userIDs ...int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.nextID++
+	e := Event{ID: b.nextID, Type: typ, Time: time.Now(), Data: data, userIDs: userIDs}
+
+	b.history = append(b.history, e)
+	if len(b.history) > b.size {
+		b.history = b.history[len(b.history)-b.size:]
+	}
+
+	for s := range b.subs {
+		if !e.deliverableTo(s.userID) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			// 따라오지 못하는 구독자는 끊는다. 재접속하면 history 에서 이어 받는다.
+			delete(b.subs, s)
+			close(s.ch)
+		}
+	}
+}
+
+// Subscribe 는 lastEventID 이후 userID 가 받을 이벤트를 돌려준다.
+// complete 가 false 면 보관 범위를 벗어나 일부 이벤트를 놓쳤다는 뜻이다.
+func (b *Broker) Subscribe(userID int, lastEventID uint64) (backlog []Event, complete bool, ch <-chan Event, cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := &subscriber{userID: userID, ch: make(chan Event, subscriberBuffer)}
+	if b.closed {
+		close(s.ch)
+		return nil, true, s.ch, func() {}
+	}
+	b.subs[s] = struct{}{}
+
+	complete = true
+	if lastEventID > 0 {
+		// 아직 발행하지 않은 ID 는 재시작 전 프로세스가 준 것이므로 그 사이 이벤트를 알 수 없다
+		if lastEventID > b.nextID || (len(b.history) > 0 && b.history[0].ID > lastEventID+1) {
+			complete = false
+		}
+		for _, e := range b.history {
+			if e.ID > lastEventID && e.deliverableTo(userID) {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.subs[s]; ok {
+			delete(b.subs, s)
+			close(s.ch)
+		}
+	}
+
+	return backlog, complete, s.ch, cancel
+}
+
+// Close 는 모든 구독을 끝낸다. 이후 발행은 무시된다.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for s := range b.subs {
+		delete(b.subs, s)
+		close(s.ch)
+	}
+}
@@ -0,0 +1,33 @@
+package events
+
+import "testing"
+
+func TestSubscribeCompleteness(t *testing.T) {
+	b := NewBroker(3)
+	for range 5 {
+		b.Publish("test", nil, 1)
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID uint64
+		complete    bool
+		backlog     int
+	}{
+		{"new connection", 0, true, 0},
+		{"up to date", 5, true, 0},
+		{"within history", 3, true, 2},
+		{"oldest kept is next", 2, true, 3},
+		{"fell out of history", 1, false, 3},
+		// 재시작 전 프로세스의 ID 로 다시 붙으면 ID 가 다시 1 부터 시작했으므로 reset 해야 한다
+		{"from before a restart", 5000, false, 0},
+		{"one past the latest", 6, false, 0},
+	}
+	for _, tt := range tests {
+		backlog, complete, _, cancel := b.Subscribe(1, tt.lastEventID)
+		cancel()
+		if complete != tt.complete || len(backlog) != tt.backlog {
+			t.Errorf("%s: complete = %t, backlog = %d; want %t, %d", tt.name, complete, len(backlog), tt.complete, tt.backlog)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Writer 는 내보내기 파일에 행을 한 줄씩 쓴다.
+// 셀 값은 string, int, int64, time.Time, *time.Time 을 받는다.
+type Writer interface {
+	Write(row []any) error
+	Close() error
+}
+
+const timeLayout = "2006-01-02 15:04:05"
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSVWriter 는 엑셀에서 한글이 깨지지 않도록 UTF-8 BOM 을 먼저 쓴다.
+func NewCSVWriter(w io.Writer) (Writer, error) {
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (cw *csvWriter) Write(row []any) error {
+	record := make([]string, len(row))
+	for i, v := range row {
+		if s, ok := v.(string); ok {
+			record[i] = escapeFormula(s)
+			continue
+		}
+		record[i] = formatCell(v)
+	}
+	return cw.w.Write(record)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// formulaPrefixes 로 시작하는 CSV 셀은 엑셀이 수식으로 읽는다.
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula 는 유저 이름, 부스 이름, 입금자명처럼 사용자가 넣은 값이 수식으로 실행되지 않도록 앞에 ' 를 붙인다.
+// xlsx 의 문자열 셀은 수식으로 읽히지 않고 ' 가 그대로 보이므로 CSV 에만 쓴다.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune(formulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func formatCell(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case time.Time:
+		return v.Local().Format(timeLayout)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.Local().Format(timeLayout)
+	default:
+		return ""
+	}
+}
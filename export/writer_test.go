@@ -0,0 +1,64 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCSVEscapesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]any{"=HYPERLINK(\"http://x\")", "+1", "-1", "@SUM(A1)", "\tx", "\rx", "홍길동", int64(-500)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := strings.TrimPrefix(buf.String(), "\ufeff")
+	want := "\"'=HYPERLINK(\"\"http://x\"\")\",'+1,'-1,'@SUM(A1),'\tx,\"'\rx\",홍길동,-500\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestXLSXKeepsStringsAsIs(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewXLSXWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]any{"=1+1", int64(-500)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := zr.Open("xl/worksheets/sheet1.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 문자열 셀은 수식으로 계산되지 않으므로 ' 를 붙이지 않는다
+	if !strings.Contains(string(sheet), `<c t="inlineStr"><is><t xml:space="preserve">=1+1</t>`) {
+		t.Errorf("string cell changed: %s", sheet)
+	}
+	if !strings.Contains(string(sheet), `<c><v>-500</v></c>`) {
+		t.Errorf("number cell changed: %s", sheet)
+	}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/user"
+	"somapay-backend/events"
+	"strconv"
+)
+
+var adjustmentReasons = map[string]bool{
+	"CORRECTION":   true,
+	"COMPENSATION": true,
+	"PRIZE":        true,
+	"PENALTY":      true,
+}
+
+func CreateAdjustmentHandler(client *ent.Client, broker *events.Broker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		targetID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		var req struct {
+			Amount int64  `json:"amount"` // 음수면 차감
+			Reason string `json:"reason"`
+			Note   string `json:"note"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		if req.Amount == 0 {
+			return apierror.InvalidField("amount")
+		}
+		if !adjustmentReasons[req.Reason] {
+			return apierror.InvalidField("reason")
+		}
+
+		admin := c.Locals("user").(*ent.User)
+
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		exists, err := tx.User.Query().Where(user.IDEQ(targetID)).Exist(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if !exists {
+			return apierror.UserNotFound
+		}
+
+		// 차감은 잔액이 충분할 때만 적용된다
+		if req.Amount < 0 {
+			_, err = debitPoints(c.Context(), tx, targetID, -req.Amount)
+		} else {
+			err = tx.User.UpdateOneID(targetID).AddPoint(req.Amount).Exec(c.Context())
+		}
+		if errors.Is(err, errInsufficientBalance) {
+			return apierror.InsufficientBalance
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		a, err := tx.Adjustment.
+			Create().
+			SetAmount(req.Amount).
+			SetReason(req.Reason).
+			SetNote(req.Note).
+			SetUserID(targetID).
+			SetAdminID(admin.ID).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if u, err := client.User.Get(c.Context(), targetID); err == nil {
+			publishBalanceChanged(broker, u)
+		}
+
+		return c.JSON(a)
+	}
+}
+
+func ListAdjustmentsHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		targetID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		if !isAdmin(c) && !isSelf(c, targetID) {
+			return apierror.Forbidden
+		}
+
+		query := client.Adjustment.
+			Query().
+			Where(adjustment.HasUserWith(user.IDEQ(targetID))).
+			Order(ent.Desc(adjustment.FieldTimestamp))
+
+		// 처리한 관리자는 관리자에게만 노출
+		if isAdmin(c) {
+			query.WithAdmin()
+		}
+
+		as, err := query.All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(as)
+	}
+}
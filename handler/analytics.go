@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/predicate"
+	"somapay-backend/ent/product"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/ent/user"
+	"time"
+)
+
+const (
+	analyticsBucketSize = 10 * time.Minute
+	analyticsTopLimit   = 10
+)
+
+type analyticsBucket struct {
+	Start   time.Time `json:"start"`
+	Charged int64     `json:"charged"`
+	Charges int       `json:"charges"`
+	Spent   int64     `json:"spent"`
+	Orders  int       `json:"orders"`
+}
+
+type topEntry struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Sales  int64  `json:"sales"`
+	Orders int    `json:"orders"`
+	Units  int64  `json:"units"`
+}
+
+// AnalyticsHandler 는 축제 전체 현황을 관리자에게 보여준다.
+// 모든 수치는 DB 집계 쿼리로 구한다.
+func AnalyticsHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		from, to, err := parseWindow(c, 24*time.Hour)
+		if err != nil {
+			return err
+		}
+
+		approvedInWindow := chargerequest.And(
+			chargerequest.StatusEQ("APPROVED"),
+			chargerequest.DecidedAtGTE(from),
+			chargerequest.DecidedAtLT(to),
+		)
+		spentInWindow := transaction.And(
+			transaction.StatusEQ("SUCCESS"),
+			transaction.TimestampGTE(from),
+			transaction.TimestampLT(to),
+		)
+
+		issued, err := scanSum(c.Context(), client.ChargeRequest.
+			Query().
+			Where(approvedInWindow).
+			Aggregate(ent.As(ent.Sum(chargerequest.FieldAmount), "sum")))
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		adjusted, err := scanSum(c.Context(), client.Adjustment.
+			Query().
+			Where(adjustment.TimestampGTE(from), adjustment.TimestampLT(to)).
+			Aggregate(ent.As(ent.Sum(adjustment.FieldAmount), "sum")))
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		spent, err := scanSum(c.Context(), client.Transaction.
+			Query().
+			Where(spentInWindow).
+			Aggregate(ent.As(ent.Sum(transaction.FieldAmount), "sum")))
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		refunded, err := scanSum(c.Context(), client.Transaction.
+			Query().
+			Where(
+				transaction.StatusEQ("REFUNDED"),
+				transaction.RefundedAtGTE(from),
+				transaction.RefundedAtLT(to),
+			).
+			Aggregate(ent.As(ent.Sum(transaction.FieldAmount), "sum")))
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		// 잔액은 기간과 무관한 현재 값
+		outstanding, err := scanSum(c.Context(), client.User.
+			Query().
+			Aggregate(ent.As(ent.Sum(user.FieldPoint), "sum")))
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		topBooths, err := topSellers(c, client, spentInWindow, transaction.BoothColumn)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if err := nameTopBooths(c, client, topBooths); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		topProducts, err := topSellers(c, client, spentInWindow, transaction.ProductColumn)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if err := nameTopProducts(c, client, topProducts); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		series, err := analyticsSeries(c, client, approvedInWindow, spentInWindow, from, to)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(fiber.Map{
+			"from":                from,
+			"to":                  to,
+			"points_issued":       issued,
+			"points_adjusted":     adjusted,
+			"total_spent":         spent,
+			"total_refunded":      refunded,
+			"outstanding_balance": outstanding,
+			"top_booths":          topBooths,
+			"top_products":        topProducts,
+			"series":              series,
+		})
+	}
+}
+
+// scanSum 은 SUM 집계 결과를 읽는다. 대상 행이 없으면 0 이다.
+func scanSum(ctx context.Context, s interface {
+	Scan(context.Context, any) error
+}) (int64, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	if err := s.Scan(ctx, &v); err != nil {
+		return 0, err
+	}
+	if len(v) == 0 {
+		return 0, nil
+	}
+	return v[0].Sum.Int64, nil
+}
+
+// topSellers 는 column(부스 또는 상품)별 매출 상위 항목을 구한다.
+func topSellers(c *fiber.Ctx, client *ent.Client, pred predicate.Transaction, column string) ([]topEntry, error) {
+	var rows []topEntry
+	err := client.Transaction.
+		Query().
+		Where(pred).
+		Modify(func(s *entsql.Selector) {
+			s.Select(
+				entsql.As(s.C(column), "id"),
+				entsql.As(entsql.Sum(s.C(transaction.FieldAmount)), "sales"),
+				entsql.As(entsql.Count("*"), "orders"),
+				entsql.As(entsql.Sum(s.C(transaction.FieldQuantity)), "units"),
+			).
+				GroupBy(s.C(column)).
+				OrderBy(entsql.Desc("sales")).
+				Limit(analyticsTopLimit)
+		}).
+		Scan(c.Context(), &rows)
+	return rows, err
+}
+
+func nameTopBooths(c *fiber.Ctx, client *ent.Client, entries []topEntry) error {
+	ids := make([]int, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+
+	bs, err := client.Booth.Query().Where(booth.IDIn(ids...)).All(c.Context())
+	if err != nil {
+		return err
+	}
+
+	names := make(map[int]string, len(bs))
+	for _, b := range bs {
+		names[b.ID] = b.Name
+	}
+	for i := range entries {
+		entries[i].Name = names[entries[i].ID]
+	}
+	return nil
+}
+
+func nameTopProducts(c *fiber.Ctx, client *ent.Client, entries []topEntry) error {
+	ids := make([]int, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+
+	ps, err := client.Product.Query().Where(product.IDIn(ids...)).All(c.Context())
+	if err != nil {
+		return err
+	}
+
+	names := make(map[int]string, len(ps))
+	for _, p := range ps {
+		names[p.ID] = p.Name
+	}
+	for i := range entries {
+		entries[i].Name = names[entries[i].ID]
+	}
+	return nil
+}
+
+func analyticsSeries(c *fiber.Ctx, client *ent.Client, charged predicate.ChargeRequest, spent predicate.Transaction, from, to time.Time) ([]analyticsBucket, error) {
+	var chargeRows []bucketRow
+	err := client.ChargeRequest.
+		Query().
+		Where(charged).
+		Modify(func(s *entsql.Selector) {
+			bucket := timeBucket(s, s.C(chargerequest.FieldDecidedAt), analyticsBucketSize)
+			s.Select(
+				entsql.As(bucket, "bucket"),
+				entsql.As(entsql.Sum(s.C(chargerequest.FieldAmount)), "amount"),
+				entsql.As(entsql.Count("*"), "count"),
+			).GroupBy(bucket)
+		}).
+		Scan(c.Context(), &chargeRows)
+	if err != nil {
+		return nil, err
+	}
+	charges := bucketsByStart(chargeRows)
+
+	sales, err := transactionBuckets(c, client, spent, analyticsBucketSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []analyticsBucket
+	for t := from.Truncate(analyticsBucketSize); t.Before(to); t = t.Add(analyticsBucketSize) {
+		cr, tr := charges[t.Unix()], sales[t.Unix()]
+		series = append(series, analyticsBucket{
+			Start:   t,
+			Charged: cr.Amount,
+			Charges: cr.Count,
+			Spent:   tr.Amount,
+			Orders:  tr.Count,
+		})
+	}
+	return series, nil
+}
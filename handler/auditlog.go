@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/auditlog"
+	"somapay-backend/ent/predicate"
+	"strconv"
+)
+
+// ListAuditLogsHandler 는 변경 기록을 최신순으로 검색한다.
+// field 로 특정 필드가 바뀐 기록만 찾을 수 있다. (예: entity_type=Product&field=price)
+func ListAuditLogsHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		var preds []predicate.AuditLog
+
+		if typ := c.Query("entity_type"); typ != "" {
+			preds = append(preds, auditlog.EntityTypeEQ(typ))
+		}
+		if action := c.Query("action"); action != "" {
+			preds = append(preds, auditlog.ActionEQ(action))
+		}
+		if f := c.Query("field"); f != "" {
+			preds = append(preds, func(s *sql.Selector) {
+				s.Where(sqljson.HasKey(auditlog.FieldChanges, sqljson.Path(f)))
+			})
+		}
+
+		filters := []struct {
+			key  string
+			pred func(int) predicate.AuditLog
+		}{
+			{"entity_id", auditlog.EntityIDEQ},
+			{"actor_id", auditlog.ActorIDEQ},
+		}
+		for _, f := range filters {
+			v, err := queryInt(c, f.key)
+			if err != nil {
+				return err
+			}
+			if v != nil {
+				preds = append(preds, f.pred(*v))
+			}
+		}
+
+		from, err := queryTime(c, "from")
+		if err != nil {
+			return err
+		}
+		if from != nil {
+			preds = append(preds, auditlog.TimestampGTE(*from))
+		}
+
+		to, err := queryTime(c, "to")
+		if err != nil {
+			return err
+		}
+		if to != nil {
+			preds = append(preds, auditlog.TimestampLT(*to))
+		}
+
+		limit, err := parseLimit(c)
+		if err != nil {
+			return err
+		}
+
+		query := client.AuditLog.Query().Where(preds...)
+
+		if cursor := c.Query("cursor"); cursor != "" {
+			lastID, err := decodeIDCursor(cursor)
+			if err != nil {
+				return err
+			}
+			query.Where(auditlog.IDLT(lastID))
+		}
+
+		logs, err := query.
+			Order(ent.Desc(auditlog.FieldID)).
+			Limit(limit + 1).
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		res := page[*ent.AuditLog]{Items: logs}
+		if len(logs) > limit {
+			res.Items = logs[:limit]
+			res.NextCursor = encodeCursor(strconv.Itoa(logs[limit-1].ID))
+		}
+
+		return c.JSON(res)
+	}
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/autoapprovalrule"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/user"
+	"strconv"
+	"time"
+)
+
+var roles = map[string]bool{
+	"USER":  true,
+	"HOST":  true,
+	"ADMIN": true,
+}
+
+func CreateAutoApprovalRuleHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		var req struct {
+			Name              string `json:"name"`
+			MaxAmount         *int64 `json:"max_amount"`
+			Role              string `json:"role"`
+			MaxDailyApprovals *int   `json:"max_daily_approvals"`
+			Enabled           *bool  `json:"enabled"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		if err := validateAutoApprovalRule(req.Name, req.MaxAmount, req.Role, req.MaxDailyApprovals); err != nil {
+			return err
+		}
+
+		q := client.AutoApprovalRule.
+			Create().
+			SetName(req.Name).
+			SetNillableMaxAmount(req.MaxAmount).
+			SetRole(req.Role).
+			SetNillableMaxDailyApprovals(req.MaxDailyApprovals)
+
+		if req.Enabled != nil {
+			q.SetEnabled(*req.Enabled)
+		}
+
+		r, err := q.Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(r)
+	}
+}
+
+func ListAutoApprovalRulesHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		rs, err := client.AutoApprovalRule.
+			Query().
+			Order(ent.Asc(autoapprovalrule.FieldID)).
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(rs)
+	}
+}
+
+func UpdateAutoApprovalRuleHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		ruleID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		var req struct {
+			Name                   *string `json:"name"`
+			MaxAmount              *int64  `json:"max_amount"`
+			Role                   *string `json:"role"`
+			MaxDailyApprovals      *int    `json:"max_daily_approvals"`
+			Enabled                *bool   `json:"enabled"`
+			ClearMaxAmount         bool    `json:"clear_max_amount"`
+			ClearMaxDailyApprovals bool    `json:"clear_max_daily_approvals"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		r, err := client.AutoApprovalRule.Get(c.Context(), ruleID)
+		if ent.IsNotFound(err) {
+			return apierror.RuleNotFound
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		// 바꾼 뒤의 규칙으로 만들 때와 같은 검사를 한다
+		name, maxAmount, role, maxDaily := r.Name, r.MaxAmount, r.Role, r.MaxDailyApprovals
+		if req.Name != nil {
+			name = *req.Name
+		}
+		if req.MaxAmount != nil {
+			maxAmount = req.MaxAmount
+		}
+		if req.ClearMaxAmount {
+			maxAmount = nil
+		}
+		if req.Role != nil {
+			role = *req.Role
+		}
+		if req.MaxDailyApprovals != nil {
+			maxDaily = req.MaxDailyApprovals
+		}
+		if req.ClearMaxDailyApprovals {
+			maxDaily = nil
+		}
+		if err := validateAutoApprovalRule(name, maxAmount, role, maxDaily); err != nil {
+			return err
+		}
+
+		q := r.Update().
+			SetName(name).
+			SetRole(role)
+
+		if maxAmount != nil {
+			q.SetMaxAmount(*maxAmount)
+		} else {
+			q.ClearMaxAmount()
+		}
+		if maxDaily != nil {
+			q.SetMaxDailyApprovals(*maxDaily)
+		} else {
+			q.ClearMaxDailyApprovals()
+		}
+		if req.Enabled != nil {
+			q.SetEnabled(*req.Enabled)
+		}
+
+		r, err = q.Save(c.Context())
+		if ent.IsNotFound(err) {
+			return apierror.RuleNotFound
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(r)
+	}
+}
+
+func DeleteAutoApprovalRuleHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		ruleID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		err = client.AutoApprovalRule.DeleteOneID(ruleID).Exec(c.Context())
+		if ent.IsNotFound(err) {
+			return apierror.RuleNotFound
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// validateAutoApprovalRule 은 저장할 규칙이 올바른지 확인한다. 만들 때와 바꿀 때 모두 쓴다.
+func validateAutoApprovalRule(name string, maxAmount *int64, role string, maxDailyApprovals *int) error {
+	if name == "" {
+		return apierror.InvalidField("name")
+	}
+	// 조건이 하나도 없으면 모든 요청이 승인되므로 막는다
+	if maxAmount == nil && role == "" && maxDailyApprovals == nil {
+		return apierror.RuleNeedsCondition
+	}
+	if maxAmount != nil && *maxAmount <= 0 {
+		return apierror.InvalidField("max_amount")
+	}
+	if role != "" && !roles[role] {
+		return apierror.InvalidField("role")
+	}
+	if maxDailyApprovals != nil && *maxDailyApprovals <= 0 {
+		return apierror.InvalidField("max_daily_approvals")
+	}
+	return nil
+}
+
+// matchAutoApprovalRule 은 활성화된 규칙 중 요청을 승인할 첫 번째 규칙을 찾는다.
+// 맞는 규칙이 없으면 nil 을 반환한다.
+// 유저 행을 잠그고 오늘 승인 횟수를 세므로, 같은 유저의 요청이 동시에 들어와도 max_daily_approvals 를 넘지 않는다.
+func matchAutoApprovalRule(ctx context.Context, tx *ent.Tx, u *ent.User, amount int64) (*ent.AutoApprovalRule, error) {
+	if err := lockUser(ctx, tx, u.ID); err != nil {
+		return nil, err
+	}
+
+	rules, err := tx.AutoApprovalRule.
+		Query().
+		Where(autoapprovalrule.EnabledEQ(true)).
+		Order(ent.Asc(autoapprovalrule.FieldID)).
+		All(ctx)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	// 오늘 승인 횟수는 필요한 규칙이 있을 때 한 번만 센다
+	approvalsToday := -1
+
+	for _, r := range rules {
+		if r.MaxAmount != nil && amount > *r.MaxAmount {
+			continue
+		}
+		if r.Role != "" && r.Role != u.Role {
+			continue
+		}
+		if r.MaxDailyApprovals != nil {
+			if approvalsToday < 0 {
+				now := time.Now()
+				today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+				approvalsToday, err = tx.ChargeRequest.
+					Query().
+					Where(
+						chargerequest.HasUserWith(user.IDEQ(u.ID)),
+						chargerequest.StatusEQ("APPROVED"),
+						chargerequest.DecidedAtGTE(today),
+					).
+					Count(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if approvalsToday >= *r.MaxDailyApprovals {
+				continue
+			}
+		}
+		return r, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"somapay-backend/config"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/events"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestAutoApprovalDailyLimitUnderConcurrency(t *testing.T) {
+	client := newTestClient(t)
+	u := newTestUser(t, client, "USER", 0)
+
+	_, err := client.AutoApprovalRule.
+		Create().
+		SetName("하루 두 번").
+		SetMaxDailyApprovals(2).
+		Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newTestApp(u)
+	app.Post("/charge-requests", CreateChargeRequestHandler(client, events.NewBroker(10), config.PaymentConfig{}))
+
+	const requests = 10
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if status, body := doJSON(t, app, "POST", "/charge-requests", map[string]any{"amount": 1000}); status != 200 {
+				t.Errorf("status = %d, body = %v", status, body)
+			}
+		}()
+	}
+	wg.Wait()
+
+	approved, err := client.ChargeRequest.Query().Where(chargerequest.StatusEQ("APPROVED")).Count(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved != 2 {
+		t.Errorf("approved = %d, want 2", approved)
+	}
+
+	pending, err := client.ChargeRequest.Query().Where(chargerequest.StatusEQ("PENDING")).Count(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != requests-2 {
+		t.Errorf("pending = %d, want %d", pending, requests-2)
+	}
+
+	after, err := client.User.Get(t.Context(), u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Point != 2000 {
+		t.Errorf("point = %d, want 2000", after.Point)
+	}
+}
+
+func TestUpdateAutoApprovalRuleKeepsACondition(t *testing.T) {
+	client := newTestClient(t)
+	admin := newTestUser(t, client, "ADMIN", 0)
+
+	r, err := client.AutoApprovalRule.
+		Create().
+		SetName("호스트").
+		SetRole("HOST").
+		Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newTestApp(admin)
+	app.Patch("/auto-approval-rules/:id", UpdateAutoApprovalRuleHandler(client))
+	path := "/auto-approval-rules/" + strconv.Itoa(r.ID)
+
+	tests := []struct {
+		body map[string]any
+		code string
+	}{
+		{map[string]any{"role": ""}, "RULE_NEEDS_CONDITION"},
+		{map[string]any{"name": ""}, "INVALID_FIELD"},
+		{map[string]any{"max_amount": 500, "role": ""}, ""},
+		{map[string]any{"clear_max_amount": true}, "RULE_NEEDS_CONDITION"},
+		{map[string]any{"clear_max_amount": true, "max_daily_approvals": 3}, ""},
+	}
+	for _, tt := range tests {
+		status, body := doJSON(t, app, "PATCH", path, tt.body)
+		if tt.code == "" {
+			if status != 200 {
+				t.Errorf("%v: status = %d, body = %v", tt.body, status, body)
+			}
+			continue
+		}
+		if body["code"] != tt.code {
+			t.Errorf("%v: code = %v, want %s", tt.body, body["code"], tt.code)
+		}
+	}
+
+	got, err := client.AutoApprovalRule.Get(t.Context(), r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.MaxAmount != nil || got.Role != "" || got.MaxDailyApprovals == nil || *got.MaxDailyApprovals != 3 {
+		t.Errorf("rule = %+v, want only max_daily_approvals = 3", got)
+	}
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/chargerequest"
+	"strconv"
+	"strings"
+)
+
+type bankDeposit struct {
+	Line      int    `json:"line"`
+	Date      string `json:"date"`
+	Depositor string `json:"depositor"`
+	Amount    int64  `json:"amount"`
+}
+
+type bankMatch struct {
+	Deposit       bankDeposit        `json:"deposit"`
+	ChargeRequest *ent.ChargeRequest `json:"charge_request"`
+	// 같은 금액과 이름의 요청이 여러 개라 가장 오래된 요청을 고른 경우
+	Ambiguous bool `json:"ambiguous"`
+}
+
+type bankInvalidRow struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// 은행 앱마다 내보내는 헤더 이름이 달라 알려진 이름들을 모두 받는다
+var bankColumnNames = map[string][]string{
+	"date":      {"date", "날짜", "거래일", "거래일자", "거래일시"},
+	"depositor": {"depositor", "입금자", "입금자명", "보낸분", "의뢰인", "적요"},
+	"amount":    {"amount", "금액", "입금액", "입금금액", "맡기신금액"},
+}
+
+// ImportBankStatementHandler 는 은행 입금 내역 CSV 를 대기 중인 충전 요청과 맞춰 승인 후보를 제안한다.
+// 실제 승인은 관리자가 확인 후 일괄 처리 엔드포인트로 진행한다.
+func ImportBankStatementHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return apierror.MissingFile
+		}
+
+		f, err := fh.Open()
+		if err != nil {
+			return apierror.InvalidFile
+		}
+		defer f.Close()
+
+		deposits, invalid, err := parseBankStatement(f)
+		if err != nil {
+			return apierror.InvalidFile.WithDetails(fiber.Map{"reason": err.Error()})
+		}
+
+		pending, err := client.ChargeRequest.
+			Query().
+			Where(chargerequest.StatusEQ("PENDING")).
+			WithUser().
+			Order(ent.Asc(chargerequest.FieldID)).
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		matches, unmatchedDeposits, unmatchedRequests := matchBankDeposits(deposits, pending)
+
+		return c.JSON(fiber.Map{
+			"matches":            matches,
+			"unmatched_deposits": unmatchedDeposits,
+			"unmatched_requests": unmatchedRequests,
+			"invalid_rows":       invalid,
+		})
+	}
+}
+
+func parseBankStatement(r io.Reader) ([]bankDeposit, []bankInvalidRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, errors.New("invalid csv")
+	}
+	if len(rows) == 0 {
+		return nil, nil, errors.New("empty csv")
+	}
+
+	rows[0][0] = strings.TrimPrefix(rows[0][0], "\uFEFF")
+
+	// 헤더가 없으면 날짜, 입금자, 금액 순서로 본다
+	dateCol, depositorCol, amountCol := 0, 1, 2
+	start := 0
+	if _, err := parseBankAmount(cellAt(rows[0], amountCol)); err != nil {
+		dateCol = findBankColumn(rows[0], "date")
+		depositorCol = findBankColumn(rows[0], "depositor")
+		amountCol = findBankColumn(rows[0], "amount")
+		if depositorCol < 0 || amountCol < 0 {
+			return nil, nil, errors.New("missing depositor or amount column")
+		}
+		start = 1
+	}
+
+	var deposits []bankDeposit
+	var invalid []bankInvalidRow
+
+	for i := start; i < len(rows); i++ {
+		row := rows[i]
+		line := i + 1
+
+		amountCell := cellAt(row, amountCol)
+		// 출금 내역처럼 입금 금액이 비어 있는 행은 건너뛴다
+		if strings.TrimSpace(amountCell) == "" {
+			continue
+		}
+
+		amount, err := parseBankAmount(amountCell)
+		if err != nil {
+			invalid = append(invalid, bankInvalidRow{Line: line, Reason: "invalid amount"})
+			continue
+		}
+
+		depositor := strings.TrimSpace(cellAt(row, depositorCol))
+		if depositor == "" {
+			invalid = append(invalid, bankInvalidRow{Line: line, Reason: "missing depositor"})
+			continue
+		}
+
+		deposits = append(deposits, bankDeposit{
+			Line:      line,
+			Date:      strings.TrimSpace(cellAt(row, dateCol)),
+			Depositor: depositor,
+			Amount:    amount,
+		})
+	}
+
+	return deposits, invalid, nil
+}
+
+// matchBankDeposits 는 금액이 같고 입금자명 또는 아이디가 같은 요청을 오래된 순으로 하나씩 짝짓는다.
+func matchBankDeposits(deposits []bankDeposit, pending []*ent.ChargeRequest) ([]bankMatch, []bankDeposit, []*ent.ChargeRequest) {
+	used := make(map[int]bool, len(pending))
+	matches := []bankMatch{}
+	unmatchedDeposits := []bankDeposit{}
+
+	for _, d := range deposits {
+		name := normalizeDepositor(d.Depositor)
+
+		var found *ent.ChargeRequest
+		candidates := 0
+		for _, cr := range pending {
+			if used[cr.ID] || cr.Amount != d.Amount {
+				continue
+			}
+			if normalizeDepositor(cr.DepositorName) != name &&
+				(cr.Edges.User == nil || normalizeDepositor(cr.Edges.User.Username) != name) {
+				continue
+			}
+
+			candidates++
+			if found == nil {
+				found = cr
+			}
+		}
+
+		if found == nil {
+			unmatchedDeposits = append(unmatchedDeposits, d)
+			continue
+		}
+
+		used[found.ID] = true
+		matches = append(matches, bankMatch{Deposit: d, ChargeRequest: found, Ambiguous: candidates > 1})
+	}
+
+	unmatchedRequests := []*ent.ChargeRequest{}
+	for _, cr := range pending {
+		if !used[cr.ID] {
+			unmatchedRequests = append(unmatchedRequests, cr)
+		}
+	}
+
+	return matches, unmatchedDeposits, unmatchedRequests
+}
+
+func findBankColumn(header []string, key string) int {
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		for _, name := range bankColumnNames[key] {
+			if h == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func cellAt(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
+
+func parseBankAmount(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(s, "원")
+	s = strings.ReplaceAll(s, ",", "")
+
+	amount, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || amount <= 0 {
+		return 0, errors.New("invalid amount")
+	}
+	return amount, nil
+}
+
+func normalizeDepositor(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), ""))
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
 	"somapay-backend/ent"
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/user"
@@ -11,7 +12,7 @@ import (
 func CreateBoothHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		var req struct {
@@ -20,7 +21,7 @@ func CreateBoothHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		u, err := client.User.
@@ -28,7 +29,7 @@ func CreateBoothHandler(client *ent.Client) fiber.Handler {
 			Where(user.UsernameEQ(req.Username)).
 			Only(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "user not found"})
+			return apierror.UserNotFound
 		}
 
 		b, err := client.Booth.
@@ -38,7 +39,7 @@ func CreateBoothHandler(client *ent.Client) fiber.Handler {
 			Save(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "failed to create"})
+			return apierror.BoothConflict
 		}
 
 		return c.JSON(b)
@@ -53,7 +54,7 @@ func ListBoothsHandler(client *ent.Client) fiber.Handler {
 			All(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(booths)
@@ -64,11 +65,11 @@ func GetBoothHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		boothID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		if !isAdmin(c) && !isHostOfBooth(c, boothID, client) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		b, err := client.Booth.
@@ -78,7 +79,7 @@ func GetBoothHandler(client *ent.Client) fiber.Handler {
 			Only(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+			return apierror.BoothNotFound
 		}
 
 		return c.JSON(b)
@@ -88,12 +89,12 @@ func GetBoothHandler(client *ent.Client) fiber.Handler {
 func UpdateBoothHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		boothID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		var req struct {
@@ -101,7 +102,7 @@ func UpdateBoothHandler(client *ent.Client) fiber.Handler {
 			Username *string `json:"username"`
 		}
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		q := client.Booth.UpdateOneID(boothID)
@@ -116,14 +117,14 @@ func UpdateBoothHandler(client *ent.Client) fiber.Handler {
 				Where(user.UsernameEQ(*req.Username)).
 				Only(c.Context())
 			if err != nil {
-				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "user not found"})
+				return apierror.UserNotFound
 			}
 			q.SetUserID(u.ID)
 		}
 
 		b, err := q.Save(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "update failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(b)
@@ -133,17 +134,17 @@ func UpdateBoothHandler(client *ent.Client) fiber.Handler {
 func DeleteBoothHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		boothID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		err = client.Booth.DeleteOneID(boothID).Exec(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "delete failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.SendStatus(fiber.StatusNoContent)
@@ -1,61 +1,170 @@
 package handler
 
 import (
+	"context"
 	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/config"
 	"somapay-backend/ent"
 	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/predicate"
+	"somapay-backend/ent/user"
+	"somapay-backend/events"
+	"somapay-backend/metrics"
+	"somapay-backend/outbox"
 	"strconv"
+	"time"
 )
 
-func CreateChargeRequestHandler(client *ent.Client) fiber.Handler {
+var paymentMethods = map[string]bool{
+	"CASH":          true,
+	"BANK_TRANSFER": true,
+}
+
+func CreateChargeRequestHandler(client *ent.Client, broker *events.Broker, limits config.PaymentConfig) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isUser(c) && !isHost(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "only users can create charge requests"})
+			return apierror.Forbidden
 		}
 
 		var req struct {
-			Amount int64 `json:"amount"`
+			Amount        int64  `json:"amount"`
+			DepositorName string `json:"depositor_name"`
+			PaymentMethod string `json:"payment_method"` // CASH / BANK_TRANSFER
 		}
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
+		}
+
+		if req.Amount <= 0 {
+			return apierror.InvalidField("amount")
+		}
+		if limits.MaxChargeAmount > 0 && req.Amount > limits.MaxChargeAmount {
+			return apierror.AmountLimitExceeded
+		}
+		if req.PaymentMethod != "" && !paymentMethods[req.PaymentMethod] {
+			return apierror.InvalidField("payment_method")
 		}
 
 		u := c.Locals("user").(*ent.User)
 
-		cr, err := client.ChargeRequest.
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		rule, err := matchAutoApprovalRule(c.Context(), tx, u, req.Amount)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		q := tx.ChargeRequest.
 			Create().
 			SetAmount(req.Amount).
-			SetUser(u).
-			Save(c.Context())
+			SetDepositorName(req.DepositorName).
+			SetPaymentMethod(req.PaymentMethod).
+			SetUser(u)
 
+		if rule != nil {
+			q.SetRule(rule)
+		}
+
+		cr, err := q.Save(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to create request"})
+			return apierror.Internal.Wrap(err)
 		}
 
-		return c.JSON(cr)
+		// 규칙에 맞는 요청은 같은 트랜잭션에서 바로 승인
+		if rule != nil {
+			if _, err := decideChargeRequest(c.Context(), tx, cr.ID, "APPROVED"); err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if rule != nil {
+			metrics.RecordChargeDecision("APPROVED", "auto", 1)
+			publishChargeRequestDecided(c, client, broker, cr.ID)
+		}
+
+		created, err := client.ChargeRequest.
+			Query().
+			Where(chargerequest.IDEQ(cr.ID)).
+			WithRule().
+			Only(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(created)
 	}
 }
 
-func UpdateChargeRequestHandler(client *ent.Client) fiber.Handler {
+func UpdateChargeRequestHandler(client *ent.Client, broker *events.Broker) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "only admin can approve/reject"})
+			return apierror.Forbidden
 		}
 
 		chargeID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		var req struct {
 			Status string `json:"status"` // APPROVED / REJECTED
 		}
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		if req.Status != "APPROVED" && req.Status != "REJECTED" {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid status"})
+			return apierror.InvalidField("status")
+		}
+
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		result, err := decideChargeRequest(c.Context(), tx, chargeID, req.Status)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		switch result {
+		case decisionNotFound:
+			return apierror.ChargeRequestNotFound
+		case decisionAlreadyDecided:
+			return apierror.ChargeRequestAlreadyDecided
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		metrics.RecordChargeDecision(req.Status, "manual", 1)
+		publishChargeRequestDecided(c, client, broker, chargeID)
+
+		updated, err := client.ChargeRequest.Get(c.Context(), chargeID)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(updated)
+	}
+}
+
+func CancelChargeRequestHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		chargeID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
 		}
 
 		cr, err := client.ChargeRequest.
@@ -63,54 +172,118 @@ func UpdateChargeRequestHandler(client *ent.Client) fiber.Handler {
 			Where(chargerequest.IDEQ(chargeID)).
 			WithUser().
 			Only(c.Context())
-
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "request not found"})
+			return apierror.ChargeRequestNotFound
 		}
 
-		// 승인 시 유저 포인트 증가
-		if req.Status == "APPROVED" {
-			_, err := client.User.
-				UpdateOne(cr.Edges.User).
-				AddPoint(cr.Amount).
-				Save(c.Context())
-			if err != nil {
-				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update user points"})
-			}
+		if !isSelf(c, cr.Edges.User.ID) {
+			return apierror.Forbidden
 		}
 
-		updated, err := cr.Update().
-			SetStatus(req.Status).
+		// 관리자가 동시에 처리하는 경우를 막기 위해 PENDING 조건으로 갱신
+		n, err := client.ChargeRequest.
+			Update().
+			Where(chargerequest.IDEQ(chargeID), chargerequest.StatusEQ("PENDING")).
+			SetStatus("CANCELED").
+			SetDecidedAt(time.Now()).
 			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if n == 0 {
+			return apierror.ChargeRequestAlreadyDecided
+		}
 
+		updated, err := client.ChargeRequest.Get(c.Context(), chargeID)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update request"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(updated)
 	}
 }
 
+const maxBatchDecisionSize = 500
+
+func BatchDecideChargeRequestsHandler(client *ent.Client, broker *events.Broker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		var req struct {
+			IDs    []int  `json:"ids"`
+			Status string `json:"status"` // APPROVED / REJECTED
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		if req.Status != "APPROVED" && req.Status != "REJECTED" {
+			return apierror.InvalidField("status")
+		}
+		if len(req.IDs) == 0 || len(req.IDs) > maxBatchDecisionSize {
+			return apierror.InvalidField("ids")
+		}
+
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		type itemResult struct {
+			ID     int    `json:"id"`
+			Result string `json:"result"`
+		}
+		results := make([]itemResult, 0, len(req.IDs))
+
+		for _, id := range req.IDs {
+			result, err := decideChargeRequest(c.Context(), tx, id, req.Status)
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+			results = append(results, itemResult{ID: id, Result: result})
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		applied := 0
+		for _, r := range results {
+			if r.Result == decisionApplied {
+				applied++
+				publishChargeRequestDecided(c, client, broker, r.ID)
+			}
+		}
+		metrics.RecordChargeDecision(req.Status, "batch", applied)
+
+		return c.JSON(fiber.Map{"status": req.Status, "results": results})
+	}
+}
+
 func GetChargeRequestHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		chargeID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		cr, err := client.ChargeRequest.
 			Query().
 			Where(chargerequest.IDEQ(chargeID)).
 			WithUser().
+			WithRule().
 			Only(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+			return apierror.ChargeRequestNotFound
 		}
 
 		u := c.Locals("user").(*ent.User)
 
 		if !isAdmin(c) && u.ID != cr.Edges.User.ID {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		return c.JSON(cr)
@@ -119,19 +292,173 @@ func GetChargeRequestHandler(client *ent.Client) fiber.Handler {
 
 func ListChargeRequestsHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "only admin allowed"})
+		preds, err := chargeRequestFilters(c)
+		if err != nil {
+			return err
 		}
 
-		crs, err := client.ChargeRequest.
+		limit, err := parseLimit(c)
+		if err != nil {
+			return err
+		}
+
+		query := client.ChargeRequest.
 			Query().
-			WithUser().
+			Where(preds...)
+
+		if cursor := c.Query("cursor"); cursor != "" {
+			lastID, err := decodeIDCursor(cursor)
+			if err != nil {
+				return err
+			}
+			query.Where(chargerequest.IDLT(lastID))
+		}
+
+		// 유저는 자기 요청만 보므로 유저 정보를 다시 실어 보내지 않는다
+		if isAdmin(c) {
+			query.WithUser().WithRule()
+		}
+
+		crs, err := query.
+			Order(ent.Desc(chargerequest.FieldID)).
+			Limit(limit + 1).
 			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		res := page[*ent.ChargeRequest]{Items: crs}
+		if len(crs) > limit {
+			res.Items = crs[:limit]
+			res.NextCursor = encodeCursor(strconv.Itoa(crs[limit-1].ID))
+		}
+
+		return c.JSON(res)
+	}
+}
 
+// chargeRequestFilters 는 목록 조회 쿼리 파라미터를 조건으로 변환한다.
+// 관리자가 아니면 항상 자기 요청으로 범위를 제한한다.
+func chargeRequestFilters(c *fiber.Ctx) ([]predicate.ChargeRequest, error) {
+	var preds []predicate.ChargeRequest
+
+	if status := c.Query("status"); status != "" {
+		preds = append(preds, chargerequest.StatusEQ(status))
+	}
+
+	if method := c.Query("payment_method"); method != "" {
+		preds = append(preds, chargerequest.PaymentMethodEQ(method))
+	}
+
+	if depositor := c.Query("depositor"); depositor != "" {
+		preds = append(preds, chargerequest.DepositorNameContainsFold(depositor))
+	}
+
+	userID, err := queryInt(c, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin(c) {
+		u := c.Locals("user").(*ent.User)
+		userID = &u.ID
+	}
+	if userID != nil {
+		preds = append(preds, chargerequest.HasUserWith(user.IDEQ(*userID)))
+	}
+
+	minAmount, err := queryInt64(c, "min_amount")
+	if err != nil {
+		return nil, err
+	}
+	if minAmount != nil {
+		preds = append(preds, chargerequest.AmountGTE(*minAmount))
+	}
+
+	maxAmount, err := queryInt64(c, "max_amount")
+	if err != nil {
+		return nil, err
+	}
+	if maxAmount != nil {
+		preds = append(preds, chargerequest.AmountLTE(*maxAmount))
+	}
+
+	from, err := queryTime(c, "from")
+	if err != nil {
+		return nil, err
+	}
+	if from != nil {
+		preds = append(preds, chargerequest.CreatedAtGTE(*from))
+	}
+
+	to, err := queryTime(c, "to")
+	if err != nil {
+		return nil, err
+	}
+	if to != nil {
+		preds = append(preds, chargerequest.CreatedAtLT(*to))
+	}
+
+	return preds, nil
+}
+
+const (
+	decisionApplied        = "applied"
+	decisionAlreadyDecided = "already_decided"
+	decisionNotFound       = "not_found"
+)
+
+// decideChargeRequest 는 PENDING 상태인 충전 요청에만 결정을 적용한다.
+// 승인 시 유저 포인트도 같은 트랜잭션에서 증가시킨다.
+func decideChargeRequest(ctx context.Context, tx *ent.Tx, chargeID int, status string) (string, error) {
+	cr, err := tx.ChargeRequest.
+		Query().
+		Where(chargerequest.IDEQ(chargeID)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return decisionNotFound, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// 동시에 처리되는 경우를 막기 위해 PENDING 조건으로 갱신
+	now := time.Now()
+	n, err := tx.ChargeRequest.
+		Update().
+		Where(chargerequest.IDEQ(chargeID), chargerequest.StatusEQ("PENDING")).
+		SetStatus(status).
+		SetDecidedAt(now).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return decisionAlreadyDecided, nil
+	}
+
+	// 승인 시 유저 포인트 증가
+	if status == "APPROVED" {
+		_, err := tx.User.
+			UpdateOneID(cr.Edges.User.ID).
+			AddPoint(cr.Amount).
+			Save(ctx)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
+			return "", err
 		}
+	}
 
-		return c.JSON(crs)
+	err = outbox.Record(ctx, tx, outbox.ChargeRequestDecided, fiber.Map{
+		"id":             cr.ID,
+		"user_id":        cr.Edges.User.ID,
+		"amount":         cr.Amount,
+		"payment_method": cr.PaymentMethod,
+		"status":         status,
+		"decided_at":     now,
+	})
+	if err != nil {
+		return "", err
 	}
+
+	return decisionApplied, nil
 }
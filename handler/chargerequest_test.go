@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"somapay-backend/ent"
+	"somapay-backend/events"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func newTestChargeRequest(t *testing.T, client *ent.Client, u *ent.User, amount int64) *ent.ChargeRequest {
+	t.Helper()
+
+	cr, err := client.ChargeRequest.Create().SetAmount(amount).SetUser(u).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cr
+}
+
+func TestDecideChargeRequestTwice(t *testing.T) {
+	client := newTestClient(t)
+	u := newTestUser(t, client, "USER", 0)
+	cr := newTestChargeRequest(t, client, u, 5000)
+
+	decide := func(status string) string {
+		t.Helper()
+
+		tx, err := client.Tx(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := decideChargeRequest(t.Context(), tx, cr.ID, status)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	if got := decide("APPROVED"); got != decisionApplied {
+		t.Fatalf("first decision = %s, want %s", got, decisionApplied)
+	}
+	for _, status := range []string{"APPROVED", "REJECTED"} {
+		if got := decide(status); got != decisionAlreadyDecided {
+			t.Errorf("deciding %s again = %s, want %s", status, got, decisionAlreadyDecided)
+		}
+	}
+
+	after, err := client.User.Get(t.Context(), u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Point != 5000 {
+		t.Errorf("point = %d, want 5000", after.Point)
+	}
+}
+
+func TestApproveChargeRequestConcurrently(t *testing.T) {
+	client := newTestClient(t)
+	admin := newTestUser(t, client, "ADMIN", 0)
+	u := newTestUser(t, client, "USER", 0)
+	cr := newTestChargeRequest(t, client, u, 5000)
+
+	app := newTestApp(admin)
+	app.Patch("/charge-requests/:id", UpdateChargeRequestHandler(client, events.NewBroker(10)))
+	path := "/charge-requests/" + strconv.Itoa(cr.ID)
+
+	const requests = 8
+	codes := make(chan any, requests)
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, body := doJSON(t, app, "PATCH", path, map[string]any{"status": "APPROVED"})
+			if status == 200 {
+				codes <- "OK"
+				return
+			}
+			codes <- body["code"]
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	ok := 0
+	for code := range codes {
+		switch code {
+		case "OK":
+			ok++
+		case "CHARGE_REQUEST_ALREADY_DECIDED":
+		default:
+			t.Errorf("unexpected code %v", code)
+		}
+	}
+	if ok != 1 {
+		t.Errorf("successful approvals = %d, want 1", ok)
+	}
+
+	after, err := client.User.Get(t.Context(), u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Point != 5000 {
+		t.Errorf("point = %d, want 5000", after.Point)
+	}
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/openapi"
+)
+
+// OpenAPIHandler 는 API 명세(OpenAPI 3)를 돌려준다.
+func OpenAPIHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		return c.Send(openapi.JSON())
+	}
+}
+
+// APIExplorerHandler 는 명세를 읽어 API 를 직접 호출해 볼 수 있는 페이지를 돌려준다.
+func APIExplorerHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Send(openapi.Explorer)
+	}
+}
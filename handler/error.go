@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"log/slog"
+	"somapay-backend/apierror"
+)
+
+// ErrorHandler 는 핸들러가 돌려준 에러를 {"code", "message", "details"} 응답으로 바꾼다.
+// 메시지 언어는 Accept-Language(ko, en)로 고르고, 서버 오류는 원인을 로그에만 남긴다.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	e := apierror.From(err)
+
+	if e.Status == fiber.StatusInternalServerError {
+		slog.Error("request failed",
+			"request_id", c.Locals("request_id"),
+			"method", c.Method(),
+			"path", c.Path(),
+			"code", e.Code,
+			"error", err,
+		)
+	}
+
+	lang := c.AcceptsLanguages(apierror.Languages...)
+	if lang == "" {
+		lang = apierror.Korean
+	}
+	c.Set(fiber.HeaderContentLanguage, lang)
+
+	body := fiber.Map{"code": e.Code, "message": e.Message(lang)}
+	if e.Details != nil {
+		body["details"] = e.Details
+	}
+	return c.Status(e.Status).JSON(body)
+}
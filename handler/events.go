@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/events"
+	"somapay-backend/middleware"
+	"strconv"
+	"time"
+)
+
+const eventHeartbeatInterval = 15 * time.Second
+
+// EventStreamHandler 는 로그인한 유저가 받을 이벤트를 Server-Sent Events 로 흘려보낸다.
+// 호스트는 자기 부스의 거래/환불을, 유저는 잔액 변경과 충전 요청 처리 결과를 받는다.
+// Last-Event-ID 헤더(또는 last_event_id 쿼리)로 놓친 이벤트부터 이어 받을 수 있다.
+func EventStreamHandler(broker *events.Broker, drain *middleware.Drain) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		u := c.Locals("user").(*ent.User)
+
+		lastID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+		var lastEventID uint64
+		if lastID != "" {
+			var err error
+			lastEventID, err = strconv.ParseUint(lastID, 10, 64)
+			if err != nil {
+				return apierror.InvalidParam("last_event_id")
+			}
+		}
+
+		backlog, complete, ch, cancel := broker.Subscribe(u.ID, lastEventID)
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		c.Set("X-Accel-Buffering", "no")
+
+		// 종료할 때는 broker.Close 로 구독이 닫히면서 스트림이 끝난다
+		done := drain.Track()
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer done()
+			defer cancel()
+
+			// 보관 범위를 넘어 놓친 이벤트가 있으면 클라이언트가 목록을 다시 불러오도록 알린다
+			if !complete {
+				fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+			}
+			for _, e := range backlog {
+				if writeEvent(w, e) != nil {
+					return
+				}
+			}
+			if w.Flush() != nil {
+				return
+			}
+
+			ticker := time.NewTicker(eventHeartbeatInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case e, ok := <-ch:
+					if !ok {
+						return
+					}
+					if writeEvent(w, e) != nil {
+						return
+					}
+				case <-ticker.C:
+					fmt.Fprint(w, ": ping\n\n")
+				}
+
+				// 연결이 끊겼는지는 Flush 가 실패하는 것으로 알 수 있다
+				if w.Flush() != nil {
+					return
+				}
+			}
+		})
+
+		return nil
+	}
+}
+
+func writeEvent(w *bufio.Writer, e events.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
+
+func publishBalanceChanged(broker *events.Broker, u *ent.User) {
+	broker.Publish("balance.changed", fiber.Map{"user_id": u.ID, "point": u.Point}, u.ID)
+}
+
+// publishTransaction 은 거래 이벤트를 구매자와 부스 호스트에게 보낸다.
+func publishTransaction(broker *events.Broker, typ string, t *ent.Transaction, hostID int) {
+	broker.Publish(typ, t, t.UserID, hostID)
+}
+
+// publishChargeRequestDecided 는 커밋된 충전 요청 처리 결과를 요청한 유저에게 알린다.
+func publishChargeRequestDecided(c *fiber.Ctx, client *ent.Client, broker *events.Broker, chargeID int) {
+	cr, err := client.ChargeRequest.
+		Query().
+		Where(chargerequest.IDEQ(chargeID)).
+		WithUser().
+		Only(c.Context())
+	if err != nil {
+		return
+	}
+
+	u := cr.Edges.User
+	cr.Edges.User = nil
+
+	broker.Publish("charge_request.decided", cr, u.ID)
+	if cr.Status == "APPROVED" {
+		publishBalanceChanged(broker, u)
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"log/slog"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/predicate"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/ent/user"
+	"somapay-backend/export"
+	"somapay-backend/middleware"
+	"time"
+)
+
+// 한 번에 읽어오는 행 수. 전체를 메모리에 올리지 않도록 id 순으로 나눠 읽는다.
+const exportBatchSize = 500
+
+var transactionExportHeader = []any{
+	"id", "timestamp", "status", "user_id", "username", "booth_id", "booth", "product_id", "product", "quantity", "amount", "refunded_at",
+}
+
+var chargeRequestExportHeader = []any{
+	"id", "created_at", "status", "user_id", "username", "amount", "payment_method", "depositor_name", "decided_at",
+}
+
+var balanceExportHeader = []any{
+	"id", "username", "role", "point",
+}
+
+func ExportTransactionsHandler(client *ent.Client, drain *middleware.Drain) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		preds, err := transactionFilters(c)
+		if err != nil {
+			return err
+		}
+
+		return streamExport(c, drain, "transactions", transactionExportHeader, func(ctx context.Context, w export.Writer) error {
+			lastID := 0
+			for {
+				ts, err := client.Transaction.
+					Query().
+					Where(preds...).
+					Where(transaction.IDGT(lastID)).
+					Order(ent.Asc(transaction.FieldID)).
+					Limit(exportBatchSize).
+					WithUser().
+					WithBooth().
+					WithProduct().
+					All(ctx)
+				if err != nil {
+					return err
+				}
+
+				for _, t := range ts {
+					row := []any{
+						t.ID, t.Timestamp, t.Status,
+						t.UserID, t.Edges.User.Username,
+						t.BoothID, t.Edges.Booth.Name,
+						t.ProductID, t.Edges.Product.Name,
+						t.Quantity, t.Amount, t.RefundedAt,
+					}
+					if err := w.Write(row); err != nil {
+						return err
+					}
+				}
+
+				if len(ts) < exportBatchSize {
+					return nil
+				}
+				lastID = ts[len(ts)-1].ID
+			}
+		})
+	}
+}
+
+func ExportChargeRequestsHandler(client *ent.Client, drain *middleware.Drain) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		preds, err := chargeRequestFilters(c)
+		if err != nil {
+			return err
+		}
+
+		return streamExport(c, drain, "charge-requests", chargeRequestExportHeader, func(ctx context.Context, w export.Writer) error {
+			lastID := 0
+			for {
+				crs, err := client.ChargeRequest.
+					Query().
+					Where(preds...).
+					Where(chargerequest.IDGT(lastID)).
+					Order(ent.Asc(chargerequest.FieldID)).
+					Limit(exportBatchSize).
+					WithUser().
+					All(ctx)
+				if err != nil {
+					return err
+				}
+
+				for _, cr := range crs {
+					row := []any{
+						cr.ID, cr.CreatedAt, cr.Status,
+						cr.Edges.User.ID, cr.Edges.User.Username,
+						cr.Amount, cr.PaymentMethod, cr.DepositorName, cr.DecidedAt,
+					}
+					if err := w.Write(row); err != nil {
+						return err
+					}
+				}
+
+				if len(crs) < exportBatchSize {
+					return nil
+				}
+				lastID = crs[len(crs)-1].ID
+			}
+		})
+	}
+}
+
+func ExportBalancesHandler(client *ent.Client, drain *middleware.Drain) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		var preds []predicate.User
+		if role := c.Query("role"); role != "" {
+			preds = append(preds, user.RoleEQ(role))
+		}
+
+		return streamExport(c, drain, "balances", balanceExportHeader, func(ctx context.Context, w export.Writer) error {
+			lastID := 0
+			for {
+				us, err := client.User.
+					Query().
+					Where(preds...).
+					Where(user.IDGT(lastID)).
+					Order(ent.Asc(user.FieldID)).
+					Limit(exportBatchSize).
+					All(ctx)
+				if err != nil {
+					return err
+				}
+
+				for _, u := range us {
+					if err := w.Write([]any{u.ID, u.Username, u.Role, u.Point}); err != nil {
+						return err
+					}
+				}
+
+				if len(us) < exportBatchSize {
+					return nil
+				}
+				lastID = us[len(us)-1].ID
+			}
+		})
+	}
+}
+
+// streamExport 는 format 쿼리(csv 기본, xlsx)에 맞는 Writer 로 응답 본문을 흘려 쓴다.
+// 본문은 핸들러가 끝난 뒤 쓰이므로 fill 은 요청 컨텍스트에 의존하면 안 된다.
+func streamExport(c *fiber.Ctx, drain *middleware.Drain, name string, header []any, fill func(ctx context.Context, w export.Writer) error) error {
+	format := c.Query("format", "csv")
+
+	var newWriter func(io.Writer) (export.Writer, error)
+	switch format {
+	case "csv":
+		newWriter = export.NewCSVWriter
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	case "xlsx":
+		newWriter = export.NewXLSXWriter
+		c.Set(fiber.HeaderContentType, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	default:
+		return apierror.InvalidParam("format")
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102-150405"), format)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	// SetBodyStreamWriter 는 함수가 어떻게 끝나든 본문을 정상 종료시키므로 파이프로 직접 흘려 쓴다.
+	// 도중에 실패하면 상태 코드를 바꿀 수 없으니 CloseWithError 로 청크 전송을 끊어
+	// 클라이언트가 잘린 파일을 완성된 파일로 받지 않게 한다.
+	requestID := c.Locals("request_id")
+	pr, pw := io.Pipe()
+	// 종료할 때 다 쓰기 전에 연결이 닫히지 않도록 처리 중인 요청으로 센다
+	done := drain.Track()
+	go func() {
+		defer done()
+
+		bw := bufio.NewWriter(pw)
+		err := writeExport(bw, newWriter, header, fill)
+		// 클라이언트가 먼저 끊으면 fasthttp 가 pr 을 닫는다. 서버 오류가 아니므로 로그에 남기지 않는다
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			slog.Error("export failed", "request_id", requestID, "name", name, "format", format, "error", err)
+		}
+		_ = pw.CloseWithError(err)
+	}()
+	c.Context().SetBodyStream(pr, -1)
+
+	return nil
+}
+
+// writeExport 는 header 와 fill 이 쓴 행을 newWriter 형식으로 bw 에 끝까지 쓴다.
+func writeExport(bw *bufio.Writer, newWriter func(io.Writer) (export.Writer, error), header []any, fill func(ctx context.Context, w export.Writer) error) error {
+	w, err := newWriter(bw)
+	if err != nil {
+		return err
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	if err := fill(context.Background(), w); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"somapay-backend/export"
+	"somapay-backend/middleware"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamExportAbortsOnError(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler, DisableStartupMessage: true})
+	app.Get("/export", func(c *fiber.Ctx) error {
+		return streamExport(c, middleware.NewDrain(), "test", []any{"id"}, func(ctx context.Context, w export.Writer) error {
+			for i := range 10000 {
+				if err := w.Write([]any{i}); err != nil {
+					return err
+				}
+			}
+			return errors.New("db gone")
+		})
+	})
+
+	// 실제 연결에서 클라이언트가 받는 모습을 보려고 app.Test 대신 서버를 띄운다
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = app.Listener(ln) }()
+	t.Cleanup(func() { _ = app.Shutdown() })
+
+	res, err := http.Get("http://" + ln.Addr().String() + "/export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d", res.StatusCode)
+	}
+
+	// 청크 전송이 마지막 청크 없이 끊기므로 잘린 본문을 정상 파일로 받지 않는다
+	body, err := io.ReadAll(res.Body)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("body read error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if !strings.HasPrefix(strings.TrimPrefix(string(body), "\ufeff"), "id\n0\n1\n") {
+		t.Errorf("body starts with %q", body[:min(len(body), 20)])
+	}
+}
+
+func TestStreamExportCompletes(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Get("/export", func(c *fiber.Ctx) error {
+		return streamExport(c, middleware.NewDrain(), "test", []any{"id"}, func(ctx context.Context, w export.Writer) error {
+			return w.Write([]any{1})
+		})
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/export", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimPrefix(string(body), "\ufeff"); got != "id\n1\n" {
+		t.Errorf("body = %q", got)
+	}
+}
+
+func TestStreamExportHoldsDrain(t *testing.T) {
+	drain := middleware.NewDrain()
+	started, release := make(chan struct{}), make(chan struct{})
+
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(drain.Handler())
+	app.Get("/export", func(c *fiber.Ctx) error {
+		return streamExport(c, drain, "test", []any{"id"}, func(ctx context.Context, w export.Writer) error {
+			close(started)
+			<-release
+			return w.Write([]any{1})
+		})
+	})
+
+	finished := make(chan error, 1)
+	go func() {
+		res, err := app.Test(httptest.NewRequest("GET", "/export", nil), -1)
+		if err == nil {
+			_, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		finished <- err
+	}()
+	<-started
+
+	// 핸들러는 이미 돌아왔지만 스트림이 끝나지 않았으므로 기다려야 한다
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if err := drain.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait = %v while the export is still streaming", err)
+	}
+
+	close(release)
+	ctx, cancel = context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	if err := drain.Wait(ctx); err != nil {
+		t.Errorf("Wait after the export finished = %v", err)
+	}
+	if err := <-finished; err != nil {
+		t.Errorf("download failed: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+	"net/http/httptest"
+	"path/filepath"
+	"somapay-backend/config"
+	"somapay-backend/ent"
+	"somapay-backend/ent/enttest"
+	"somapay-backend/storage"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestClient 는 임시 디렉터리의 SQLite 파일에 스키마를 만든 클라이언트를 돌려준다.
+// 서버와 같은 설정(_txlock=immediate, busy_timeout)으로 연다.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, dialectName, err := storage.OpenDB(config.DatabaseConfig{
+		Driver: "sqlite",
+		DSN:    filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialectName, db))))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+var testUserSeq atomic.Int64
+
+// newTestUser 는 role 과 잔액 point 를 가진 유저를 만든다. PIN 은 1234 다.
+func newTestUser(t *testing.T, client *ent.Client, role string, point int64) *ent.User {
+	t.Helper()
+
+	u, err := client.User.
+		Create().
+		SetUsername("test" + strconv.FormatInt(testUserSeq.Add(1), 10)).
+		SetPassword("x").
+		SetPin("1234").
+		SetRole(role).
+		SetPoint(point).
+		Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// newTestApp 은 u 로 로그인한 것처럼 요청을 처리하는 앱을 만든다.
+func newTestApp(u *ent.User) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", u)
+		return c.Next()
+	})
+	return app
+}
+
+// doJSON 은 body 를 JSON 으로 보내고 상태 코드와 JSON 응답을 돌려준다.
+func doJSON(t *testing.T, app *fiber.App, method, path string, body any) (int, map[string]any) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	res, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var out map[string]any
+	_ = json.NewDecoder(res.Body).Decode(&out)
+	return res.StatusCode, out
+}
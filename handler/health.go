@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"time"
+)
+
+const readyTimeout = 2 * time.Second
+
+// HealthzHandler 는 프로세스가 살아 있으면 200 을 돌려준다.
+func HealthzHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"status": "ok"})
+	}
+}
+
+// ReadyzHandler 는 요청을 받을 수 있는지 ready 로 확인한다.
+// DB 에 닿지 않거나, 스키마가 맞지 않거나, 종료 중이면 503 을 돌려준다.
+func ReadyzHandler(ready func(ctx context.Context) error) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(context.Background(), readyTimeout)
+		defer cancel()
+
+		if err := ready(ctx); err != nil {
+			return apierror.Unavailable.WithDetails(fiber.Map{"reason": err.Error()})
+		}
+		return c.JSON(fiber.Map{"status": "ok"})
+	}
+}
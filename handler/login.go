@@ -3,6 +3,7 @@ package handler
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"somapay-backend/apierror"
 	"somapay-backend/ent"
 	"somapay-backend/ent/user"
 	"somapay-backend/storage"
@@ -16,7 +17,7 @@ func LoginHandler(client *ent.Client, sessionStore *storage.SessionStore) fiber.
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		u, err := client.User.
@@ -24,20 +25,18 @@ func LoginHandler(client *ent.Client, sessionStore *storage.SessionStore) fiber.
 			Where(user.UsernameEQ(req.Username)).
 			Only(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "user not found"})
+			return apierror.InvalidCredentials
 		}
 
 		if !checkPasswordHash(req.Password, u.Password) {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid password"})
+			return apierror.InvalidCredentials
 		}
 
 		// UUID 생성
 		token := uuid.New().String()
 
 		// 메모리 스토어에 저장
-		sessionStore.Lock()
-		sessionStore.Data[token] = u.ID
-		sessionStore.Unlock()
+		sessionStore.Add(token, u.ID)
 
 		return c.JSON(fiber.Map{"userId": u.ID, "token": token})
 	}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/adjustment"
+	"somapay-backend/ent/chargerequest"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/ent/user"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// 활동 내역 종류. 같은 시각이면 rank 가 큰 쪽을 더 최근으로 본다.
+const (
+	activityAdjustment = "adjustment"
+	activityCharge     = "charge"
+	activityPurchase   = "purchase"
+	activityRefund     = "refund"
+)
+
+var activityRanks = map[string]int{
+	activityAdjustment: 0,
+	activityCharge:     1,
+	activityPurchase:   2,
+	activityRefund:     3,
+}
+
+type activityItem struct {
+	Type     string    `json:"type"`
+	ID       int       `json:"id"`
+	Time     time.Time `json:"time"`
+	Amount   int64     `json:"amount"`  // 잔액 변화량
+	Balance  int64     `json:"balance"` // 이 항목이 반영된 직후 잔액
+	Status   string    `json:"status,omitempty"`
+	Booth    string    `json:"booth,omitempty"`
+	Product  string    `json:"product,omitempty"`
+	Quantity int64     `json:"quantity,omitempty"`
+	Reason   string    `json:"reason,omitempty"`
+	Note     string    `json:"note,omitempty"`
+}
+
+// activityCursor 는 이전 페이지 마지막 항목의 정렬 키와 그 항목 직전의 잔액이다.
+type activityCursor struct {
+	time    time.Time
+	rank    int
+	id      int
+	balance int64
+}
+
+func MeHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		u := c.Locals("user").(*ent.User)
+
+		me, err := client.User.
+			Query().
+			Where(user.IDEQ(u.ID)).
+			WithBooth().
+			Only(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(fiber.Map{
+			"id":       me.ID,
+			"username": me.Username,
+			"role":     me.Role,
+			"point":    me.Point,
+			"booth":    me.Edges.Booth,
+		})
+	}
+}
+
+// MyActivityHandler 는 충전, 결제, 환불, 관리자 조정을 하나의 최신순 목록으로 합쳐 보여준다.
+// 각 항목에는 그 시점의 잔액이 붙는다. 현재 잔액에서 거꾸로 계산하며, 이어지는 페이지는 커서에 담긴 잔액에서 계속한다.
+func MyActivityHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		u := c.Locals("user").(*ent.User)
+
+		limit, err := parseLimit(c)
+		if err != nil {
+			return err
+		}
+
+		var cur *activityCursor
+		if raw := c.Query("cursor"); raw != "" {
+			cur, err = decodeActivityCursor(raw)
+			if err != nil {
+				return err
+			}
+		}
+
+		var balance int64
+		if cur != nil {
+			balance = cur.balance
+		} else {
+			me, err := client.User.Get(c.Context(), u.ID)
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+			balance = me.Point
+		}
+
+		// 각 종류에서 limit+1 개씩 가져와 합친 뒤 다시 자른다
+		var items []activityItem
+		sources := []func(context.Context, *ent.Client, int, *activityCursor, int) ([]activityItem, error){
+			adjustmentActivity,
+			chargeActivity,
+			purchaseActivity,
+			refundActivity,
+		}
+		for _, src := range sources {
+			got, err := src(c.Context(), client, u.ID, cur, limit+1)
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+			items = append(items, got...)
+		}
+
+		sort.Slice(items, func(i, j int) bool {
+			return activityNewer(items[i], items[j])
+		})
+
+		hasMore := len(items) > limit
+		if hasMore {
+			items = items[:limit]
+		}
+
+		for i := range items {
+			items[i].Balance = balance
+			balance -= items[i].Amount
+		}
+
+		res := page[activityItem]{Items: items}
+		if res.Items == nil {
+			res.Items = []activityItem{}
+		}
+		if hasMore {
+			last := items[len(items)-1]
+			res.NextCursor = encodeCursor(
+				last.Time.Format(time.RFC3339Nano),
+				strconv.Itoa(activityRanks[last.Type]),
+				strconv.Itoa(last.ID),
+				strconv.FormatInt(balance, 10),
+			)
+		}
+
+		return c.JSON(res)
+	}
+}
+
+func activityNewer(a, b activityItem) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.After(b.Time)
+	}
+	if ra, rb := activityRanks[a.Type], activityRanks[b.Type]; ra != rb {
+		return ra > rb
+	}
+	return a.ID > b.ID
+}
+
+func decodeActivityCursor(raw string) (*activityCursor, error) {
+	parts, err := decodeCursor(raw, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	rank, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	balance, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &activityCursor{time: t, rank: rank, id: id, balance: balance}, nil
+}
+
+// olderThan 은 typ 종류의 항목 중 커서보다 오래된 것만 남기는 조건이다.
+func olderThan(cur *activityCursor, typ, timeColumn string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if cur == nil {
+			return
+		}
+
+		col := s.C(timeColumn)
+		switch rank := activityRanks[typ]; {
+		case rank < cur.rank:
+			s.Where(sql.LTE(col, cur.time))
+		case rank > cur.rank:
+			s.Where(sql.LT(col, cur.time))
+		default:
+			s.Where(sql.Or(
+				sql.LT(col, cur.time),
+				sql.And(sql.EQ(col, cur.time), sql.LT(s.C("id"), cur.id)),
+			))
+		}
+	}
+}
+
+func adjustmentActivity(ctx context.Context, client *ent.Client, userID int, cur *activityCursor, n int) ([]activityItem, error) {
+	as, err := client.Adjustment.
+		Query().
+		Where(
+			adjustment.HasUserWith(user.IDEQ(userID)),
+			olderThan(cur, activityAdjustment, adjustment.FieldTimestamp),
+		).
+		Order(ent.Desc(adjustment.FieldTimestamp), ent.Desc(adjustment.FieldID)).
+		Limit(n).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, len(as))
+	for i, a := range as {
+		items[i] = activityItem{
+			Type:   activityAdjustment,
+			ID:     a.ID,
+			Time:   a.Timestamp,
+			Amount: a.Amount,
+			Reason: a.Reason,
+			Note:   a.Note,
+		}
+	}
+	return items, nil
+}
+
+// chargeActivity 는 처리가 끝난 충전 요청을 처리 시각 기준으로 보여준다.
+// 승인된 요청만 잔액을 바꾼다.
+func chargeActivity(ctx context.Context, client *ent.Client, userID int, cur *activityCursor, n int) ([]activityItem, error) {
+	crs, err := client.ChargeRequest.
+		Query().
+		Where(
+			chargerequest.HasUserWith(user.IDEQ(userID)),
+			chargerequest.DecidedAtNotNil(),
+			olderThan(cur, activityCharge, chargerequest.FieldDecidedAt),
+		).
+		Order(ent.Desc(chargerequest.FieldDecidedAt), ent.Desc(chargerequest.FieldID)).
+		Limit(n).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, len(crs))
+	for i, cr := range crs {
+		items[i] = activityItem{
+			Type:   activityCharge,
+			ID:     cr.ID,
+			Time:   *cr.DecidedAt,
+			Status: cr.Status,
+		}
+		if cr.Status == "APPROVED" {
+			items[i].Amount = cr.Amount
+		}
+	}
+	return items, nil
+}
+
+func purchaseActivity(ctx context.Context, client *ent.Client, userID int, cur *activityCursor, n int) ([]activityItem, error) {
+	ts, err := client.Transaction.
+		Query().
+		Where(
+			transaction.UserIDEQ(userID),
+			olderThan(cur, activityPurchase, transaction.FieldTimestamp),
+		).
+		Order(ent.Desc(transaction.FieldTimestamp), ent.Desc(transaction.FieldID)).
+		Limit(n).
+		WithBooth().
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, len(ts))
+	for i, t := range ts {
+		items[i] = activityItem{
+			Type:     activityPurchase,
+			ID:       t.ID,
+			Time:     t.Timestamp,
+			Amount:   -t.Amount,
+			Status:   t.Status,
+			Booth:    t.Edges.Booth.Name,
+			Product:  t.Edges.Product.Name,
+			Quantity: t.Quantity,
+		}
+	}
+	return items, nil
+}
+
+// refundActivity 는 환불된 거래를 환불 시각에 다시 한 번 보여준다. id 는 거래 id 다.
+func refundActivity(ctx context.Context, client *ent.Client, userID int, cur *activityCursor, n int) ([]activityItem, error) {
+	ts, err := client.Transaction.
+		Query().
+		Where(
+			transaction.UserIDEQ(userID),
+			transaction.RefundedAtNotNil(),
+			olderThan(cur, activityRefund, transaction.FieldRefundedAt),
+		).
+		Order(ent.Desc(transaction.FieldRefundedAt), ent.Desc(transaction.FieldID)).
+		Limit(n).
+		WithBooth().
+		WithProduct().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, len(ts))
+	for i, t := range ts {
+		items[i] = activityItem{
+			Type:     activityRefund,
+			ID:       t.ID,
+			Time:     *t.RefundedAt,
+			Amount:   t.Amount,
+			Booth:    t.Edges.Booth.Name,
+			Product:  t.Edges.Product.Name,
+			Quantity: t.Quantity,
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"somapay-backend/apierror"
+	"somapay-backend/metrics"
+)
+
+// MetricsHandler 는 Prometheus 지표를 내보낸다. 허용된 네트워크에서 왔거나 관리자만 볼 수 있다.
+func MetricsHandler() fiber.Handler {
+	h := adaptor.HTTPHandler(metrics.Handler())
+
+	return func(c *fiber.Ctx) error {
+		if trusted, _ := c.Locals("trusted_network").(bool); !trusted && !isAdmin(c) {
+			return apierror.Forbidden
+		}
+		return h(c)
+	}
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/events"
+	"strconv"
+)
+
+// 주문 처리 단계. 부스는 앞 단계에서 뒤 단계로만 진행시킬 수 있다.
+var fulfillmentSteps = map[string]int{
+	"RECEIVED":  0,
+	"PREPARING": 1,
+	"READY":     2,
+	"PICKED_UP": 3,
+}
+
+// 아직 조리 중이라 대기 순번에 포함되는 단계
+var waitingSteps = []string{"RECEIVED", "PREPARING"}
+
+// BoothOrderQueueHandler 는 부스에서 아직 수령되지 않은 주문을 오래된 순으로 보여준다.
+// 환불된 주문은 대기열에서 빠진다.
+func BoothOrderQueueHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boothID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		if !isAdmin(c) && !isHostOfBooth(c, boothID, client) {
+			return apierror.Forbidden
+		}
+
+		query := client.Transaction.
+			Query().
+			Where(
+				transaction.BoothIDEQ(boothID),
+				transaction.StatusEQ("SUCCESS"),
+				transaction.FulfillmentStatusNEQ("PICKED_UP"),
+			)
+
+		if status := c.Query("fulfillment_status"); status != "" {
+			if _, ok := fulfillmentSteps[status]; !ok {
+				return apierror.InvalidParam("fulfillment_status")
+			}
+			query.Where(transaction.FulfillmentStatusEQ(status))
+		}
+
+		ts, err := query.
+			Order(ent.Asc(transaction.FieldID)).
+			WithProduct().
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(ts)
+	}
+}
+
+// GetOrderStatusHandler 는 구매자가 자기 주문 번호와 처리 상태, 앞에 남은 주문 수를 확인하게 한다.
+func GetOrderStatusHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		txID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		t, err := client.Transaction.
+			Query().
+			Where(transaction.IDEQ(txID)).
+			WithBooth().
+			WithProduct().
+			Only(c.Context())
+		if err != nil {
+			return apierror.TransactionNotFound
+		}
+
+		if !isSelf(c, t.UserID) && !isAdmin(c) && !isHostOfBooth(c, t.BoothID, client) {
+			return apierror.Forbidden
+		}
+
+		ahead := 0
+		if t.Status == "SUCCESS" && fulfillmentSteps[t.FulfillmentStatus] < fulfillmentSteps["READY"] {
+			ahead, err = client.Transaction.
+				Query().
+				Where(
+					transaction.BoothIDEQ(t.BoothID),
+					transaction.StatusEQ("SUCCESS"),
+					transaction.FulfillmentStatusIn(waitingSteps...),
+					transaction.IDLT(t.ID),
+				).
+				Count(c.Context())
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+		}
+
+		return c.JSON(fiber.Map{
+			"transaction_id":     t.ID,
+			"booth_id":           t.BoothID,
+			"booth":              t.Edges.Booth.Name,
+			"product":            t.Edges.Product.Name,
+			"quantity":           t.Quantity,
+			"order_number":       t.OrderNumber,
+			"status":             t.Status,
+			"fulfillment_status": t.FulfillmentStatus,
+			"orders_ahead":       ahead,
+		})
+	}
+}
+
+// UpdateFulfillmentHandler 는 부스 호스트가 주문 처리 단계를 진행시킨다.
+func UpdateFulfillmentHandler(client *ent.Client, broker *events.Broker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		txID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		var req struct {
+			Status string `json:"status"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		next, ok := fulfillmentSteps[req.Status]
+		if !ok {
+			return apierror.InvalidField("status")
+		}
+
+		t, err := client.Transaction.Get(c.Context(), txID)
+		if err != nil {
+			return apierror.TransactionNotFound
+		}
+
+		if !isAdmin(c) && !isHostOfBooth(c, t.BoothID, client) {
+			return apierror.Forbidden
+		}
+
+		if t.Status != "SUCCESS" {
+			return apierror.TransactionNotFulfillable
+		}
+		if next <= fulfillmentSteps[t.FulfillmentStatus] {
+			return apierror.FulfillmentBackward
+		}
+
+		// 읽은 뒤 다른 요청이 먼저 바꿨거나 환불됐다면 갱신되지 않는다
+		n, err := client.Transaction.
+			Update().
+			Where(
+				transaction.IDEQ(t.ID),
+				transaction.StatusEQ("SUCCESS"),
+				transaction.FulfillmentStatusEQ(t.FulfillmentStatus),
+			).
+			SetFulfillmentStatus(req.Status).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if n == 0 {
+			return apierror.OrderChanged
+		}
+
+		updated, err := client.Transaction.Get(c.Context(), t.ID)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		hostID, err := client.Booth.
+			Query().
+			Where(booth.IDEQ(t.BoothID)).
+			QueryUser().
+			OnlyID(c.Context())
+		if err == nil {
+			publishTransaction(broker, "order.updated", updated, hostID)
+		}
+
+		return c.JSON(updated)
+	}
+}
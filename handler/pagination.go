@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/base64"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+var errInvalidCursor = apierror.InvalidParam("cursor")
+
+type page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int   `json:"total,omitempty"`
+}
+
+func parseLimit(c *fiber.Ctx) (int, error) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return defaultPageLimit, nil
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit <= 0 {
+		return 0, apierror.InvalidParam("limit")
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	return limit, nil
+}
+
+// encodeCursor 는 정렬 키들을 클라이언트에 그대로 노출하지 않도록 감싼다.
+func encodeCursor(parts ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, "|")))
+}
+
+func decodeCursor(cursor string, n int) ([]string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != n {
+		return nil, errInvalidCursor
+	}
+	return parts, nil
+}
+
+func decodeIDCursor(cursor string) (int, error) {
+	parts, err := decodeCursor(cursor, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	return id, nil
+}
+
+func queryInt(c *fiber.Ctx, key string) (*int, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, apierror.InvalidParam(key)
+	}
+	return &v, nil
+}
+
+func queryInt64(c *fiber.Ctx, key string) (*int64, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, apierror.InvalidParam(key)
+	}
+	return &v, nil
+}
+
+// queryTime 은 RFC3339 형식의 시간 쿼리 파라미터를 읽는다.
+// SQLite 는 시간을 텍스트로 저장해 문자열로 비교하므로, 요청의 오프셋과 상관없이
+// 행을 쓸 때(time.Now)와 같은 서버 시간대로 바꿔 모든 드라이버에서 같은 행을 고르게 한다.
+func queryTime(c *fiber.Ctx, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, apierror.InvalidParam(key)
+	}
+	v = v.Local()
+	return &v, nil
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTimeFilterIgnoresRequestOffset(t *testing.T) {
+	// 서버가 UTC 가 아닌 시간대에서 돌 때 SQLite 에 저장된 시간과 요청 시간의 오프셋이 달라진다
+	kst := time.FixedZone("KST", 9*60*60)
+	local := time.Local
+	time.Local = kst
+	t.Cleanup(func() { time.Local = local })
+
+	client := newTestClient(t)
+	admin := newTestUser(t, client, "ADMIN", 0)
+	host := newTestUser(t, client, "HOST", 0)
+	buyer := newTestUser(t, client, "USER", 0)
+
+	// 09:00 KST(00:00 UTC) 앞뒤로 30분씩 떨어진 거래
+	for _, ts := range []time.Time{
+		time.Date(2026, 10, 19, 8, 30, 0, 0, kst),
+		time.Date(2026, 10, 19, 9, 30, 0, 0, kst),
+	} {
+		tr := newTestPurchase(t, client, host, buyer, 1000)
+		if err := client.Transaction.UpdateOne(tr).SetTimestamp(ts).Exec(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		host = newTestUser(t, client, "HOST", 0)
+	}
+
+	app := newTestApp(admin)
+	app.Get("/transactions", ListTransactionsHandler(client))
+
+	// 모두 같은 순간(09:00 KST)을 가리킨다
+	for _, from := range []string{"2026-10-19T09:00:00+09:00", "2026-10-19T00:00:00Z", "2026-10-18T19:00:00-05:00"} {
+		status, body := doJSON(t, app, "GET", "/transactions?from="+url.QueryEscape(from), nil)
+		if status != 200 {
+			t.Fatalf("from=%s: status = %d, body = %v", from, status, body)
+		}
+		if body["total"] != float64(1) {
+			t.Errorf("from=%s: total = %v, want 1", from, body["total"])
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"somapay-backend/ent"
+	"somapay-backend/ent/user"
+)
+
+var errInsufficientBalance = errors.New("not enough balance")
+
+// debitPoints 는 잔액이 amount 이상일 때만 차감한다.
+// 잔액 확인과 차감을 UPDATE ... WHERE point >= amount 한 문장으로 처리하므로
+// SELECT ... FOR UPDATE 같은 DB 별 잠금 없이도 MySQL, PostgreSQL, SQLite 에서 똑같이 동시 결제에 안전하다.
+func debitPoints(ctx context.Context, tx *ent.Tx, userID int, amount int64) (*ent.User, error) {
+	n, err := tx.User.
+		Update().
+		Where(user.IDEQ(userID), user.PointGTE(amount)).
+		AddPoint(-amount).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errInsufficientBalance
+	}
+
+	return tx.User.Get(ctx, userID)
+}
+
+// lockUser 는 트랜잭션이 끝날 때까지 유저 행을 잠근다.
+// 하루 승인 횟수처럼 읽은 값으로 판단한 뒤 쓰는 경우, 같은 유저의 요청이 동시에 같은 값을 읽지 않도록 판단 전에 부른다.
+// MySQL 은 잠근 뒤 처음 읽을 때 스냅샷을 만들므로 트랜잭션에서 가장 먼저 불러야 한다.
+// SQLite 에는 FOR UPDATE 가 없지만 쓰기 트랜잭션을 BEGIN IMMEDIATE 로 시작하므로 이미 한 번에 하나씩만 돈다.
+func lockUser(ctx context.Context, tx *ent.Tx, userID int) error {
+	_, err := tx.User.
+		Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldID).
+		Modify(func(s *sql.Selector) {
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		}).
+		Ints(ctx)
+	return err
+}
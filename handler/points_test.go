@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDebitPointsInsufficientBalance(t *testing.T) {
+	client := newTestClient(t)
+	u := newTestUser(t, client, "USER", 1000)
+
+	tests := []struct {
+		amount int64
+		err    error
+		point  int64
+	}{
+		{1001, errInsufficientBalance, 1000},
+		{1000, nil, 0},
+		{1, errInsufficientBalance, 0},
+	}
+	for _, tt := range tests {
+		tx, err := client.Tx(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = debitPoints(t.Context(), tx, u.ID, tt.amount)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("debit %d: err = %v, want %v", tt.amount, err, tt.err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+
+		after, err := client.User.Get(t.Context(), u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if after.Point != tt.point {
+			t.Errorf("debit %d: point = %d, want %d", tt.amount, after.Point, tt.point)
+		}
+	}
+}
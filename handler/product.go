@@ -2,7 +2,7 @@ package handler
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"log"
+	"somapay-backend/apierror"
 	"somapay-backend/ent"
 	"somapay-backend/ent/booth"
 	"somapay-backend/ent/product"
@@ -13,12 +13,12 @@ func GetProductHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		productID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		p, err := client.Product.Get(c.Context(), productID)
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+			return apierror.ProductNotFound
 		}
 
 		return c.JSON(p)
@@ -35,11 +35,11 @@ func CreateProductHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		if !canManageProduct(c, req.BoothID, client) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		p, err := client.Product.
@@ -51,7 +51,7 @@ func CreateProductHandler(client *ent.Client) fiber.Handler {
 			Save(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "create failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(p)
@@ -61,12 +61,12 @@ func CreateProductHandler(client *ent.Client) fiber.Handler {
 func ListAllProductsHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		ps, err := client.Product.Query().All(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(ps)
@@ -77,7 +77,7 @@ func ListProductsByBoothHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		boothID, err := strconv.Atoi(c.Params("booth_id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid booth id"})
+			return apierror.InvalidParam("booth_id")
 		}
 
 		ps, err := client.Product.
@@ -86,7 +86,7 @@ func ListProductsByBoothHandler(client *ent.Client) fiber.Handler {
 			All(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(ps)
@@ -97,7 +97,7 @@ func UpdateProductHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		productID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		boothID, err := client.Product.
@@ -106,11 +106,11 @@ func UpdateProductHandler(client *ent.Client) fiber.Handler {
 			QueryBooth().
 			OnlyID(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "booth not found"})
+			return apierror.BoothNotFound
 		}
 
 		if !canManageProduct(c, boothID, client) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		var req struct {
@@ -120,8 +120,7 @@ func UpdateProductHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			log.Fatalf("failed to parse body: %v", err)
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		q := client.Product.UpdateOneID(productID)
@@ -138,7 +137,7 @@ func UpdateProductHandler(client *ent.Client) fiber.Handler {
 
 		updated, err := q.Save(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "update failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(updated)
@@ -149,7 +148,7 @@ func DeleteProductHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		productID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		boothID, err := client.Product.
@@ -158,15 +157,15 @@ func DeleteProductHandler(client *ent.Client) fiber.Handler {
 			QueryBooth().
 			OnlyID(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "booth not found"})
+			return apierror.BoothNotFound
 		}
 
 		if !canManageProduct(c, boothID, client) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		if err := client.Product.DeleteOneID(productID).Exec(c.Context()); err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "delete failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.SendStatus(fiber.StatusNoContent)
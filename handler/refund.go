@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/transaction"
+	"somapay-backend/events"
+	"somapay-backend/outbox"
+	"strconv"
+	"time"
+)
+
+// RefundTransactionHandler 는 결제를 취소하고 구매자에게 금액을 돌려준다. 관리자와 그 부스의 호스트만 할 수 있다.
+// SUCCESS 인 거래만 REFUNDED 로 바꾸는 조건부 갱신이라 같은 거래를 두 번 환불할 수 없다.
+func RefundTransactionHandler(client *ent.Client, broker *events.Broker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		txID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		t, err := client.Transaction.Get(c.Context(), txID)
+		if err != nil {
+			return apierror.TransactionNotFound
+		}
+
+		if !isAdmin(c) && !isHostOfBooth(c, t.BoothID, client) {
+			return apierror.Forbidden
+		}
+
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		// 중복 환불을 막기 위해 SUCCESS 조건으로 갱신
+		n, err := tx.Transaction.
+			Update().
+			Where(transaction.IDEQ(t.ID), transaction.StatusEQ("SUCCESS")).
+			SetStatus("REFUNDED").
+			SetRefundedAt(time.Now()).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if n == 0 {
+			return apierror.TransactionNotRefundable
+		}
+
+		refunded, err := tx.Transaction.Get(c.Context(), t.ID)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if err := outbox.Record(c.Context(), tx, outbox.TransactionRefunded, refunded); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		// User 포인트 복구
+		buyer, err := tx.User.UpdateOneID(t.UserID).
+			AddPoint(t.Amount).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		hostID, err := tx.Booth.
+			Query().
+			Where(booth.IDEQ(t.BoothID)).
+			QueryUser().
+			OnlyID(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		publishTransaction(broker, "transaction.refunded", refunded, hostID)
+		publishBalanceChanged(broker, buyer)
+
+		return c.JSON(refunded)
+	}
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"somapay-backend/ent"
+	"somapay-backend/events"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// newTestPurchase 는 host 의 부스에서 buyer 가 amount 만큼 결제한 거래를 만든다. 잔액은 건드리지 않는다.
+func newTestPurchase(t *testing.T, client *ent.Client, host, buyer *ent.User, amount int64) *ent.Transaction {
+	t.Helper()
+
+	b, err := client.Booth.Create().SetName("부스" + strconv.Itoa(host.ID)).SetUser(host).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := client.Product.Create().SetName("상품").SetPrice(amount).SetBooth(b).Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr, err := client.Transaction.
+		Create().
+		SetQuantity(1).
+		SetAmount(amount).
+		SetStatus("SUCCESS").
+		SetUser(buyer).
+		SetBooth(b).
+		SetProduct(p).
+		Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr
+}
+
+func TestRefundTwice(t *testing.T) {
+	client := newTestClient(t)
+	host := newTestUser(t, client, "HOST", 0)
+	buyer := newTestUser(t, client, "USER", 0)
+	tr := newTestPurchase(t, client, host, buyer, 3000)
+
+	app := newTestApp(host)
+	app.Post("/transactions/:id/refund", RefundTransactionHandler(client, events.NewBroker(10)))
+	path := "/transactions/" + strconv.Itoa(tr.ID) + "/refund"
+
+	status, body := doJSON(t, app, "POST", path, nil)
+	if status != 200 || body["status"] != "REFUNDED" {
+		t.Fatalf("first refund: status = %d, body = %v", status, body)
+	}
+
+	status, body = doJSON(t, app, "POST", path, nil)
+	if status != 409 || body["code"] != "TRANSACTION_NOT_REFUNDABLE" {
+		t.Errorf("second refund: status = %d, body = %v", status, body)
+	}
+
+	after, err := client.User.Get(t.Context(), buyer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Point != 3000 {
+		t.Errorf("point = %d, want 3000", after.Point)
+	}
+}
+
+func TestRefundConcurrently(t *testing.T) {
+	client := newTestClient(t)
+	host := newTestUser(t, client, "HOST", 0)
+	buyer := newTestUser(t, client, "USER", 0)
+	tr := newTestPurchase(t, client, host, buyer, 3000)
+
+	app := newTestApp(host)
+	app.Post("/transactions/:id/refund", RefundTransactionHandler(client, events.NewBroker(10)))
+	path := "/transactions/" + strconv.Itoa(tr.ID) + "/refund"
+
+	const requests = 8
+	statuses := make(chan int, requests)
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, _ := doJSON(t, app, "POST", path, nil)
+			statuses <- status
+		}()
+	}
+	wg.Wait()
+	close(statuses)
+
+	ok := 0
+	for s := range statuses {
+		switch s {
+		case 200:
+			ok++
+		case 409:
+		default:
+			t.Errorf("unexpected status %d", s)
+		}
+	}
+	if ok != 1 {
+		t.Errorf("successful refunds = %d, want 1", ok)
+	}
+
+	after, err := client.User.Get(t.Context(), buyer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Point != 3000 {
+		t.Errorf("point = %d, want 3000", after.Point)
+	}
+}
+
+func TestRefundOtherBooth(t *testing.T) {
+	client := newTestClient(t)
+	host := newTestUser(t, client, "HOST", 0)
+	otherHost := newTestUser(t, client, "HOST", 0)
+	buyer := newTestUser(t, client, "USER", 0)
+	tr := newTestPurchase(t, client, host, buyer, 3000)
+
+	app := newTestApp(otherHost)
+	app.Post("/transactions/:id/refund", RefundTransactionHandler(client, events.NewBroker(10)))
+
+	status, body := doJSON(t, app, "POST", "/transactions/"+strconv.Itoa(tr.ID)+"/refund", nil)
+	if status != 403 || body["code"] != "FORBIDDEN" {
+		t.Errorf("status = %d, body = %v", status, body)
+	}
+}
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/predicate"
+	"somapay-backend/ent/product"
+	"somapay-backend/ent/transaction"
+	"strconv"
+	"time"
+)
+
+const maxStatsWindow = 7 * 24 * time.Hour
+
+type productStat struct {
+	ProductID int    `json:"product_id"`
+	Name      string `json:"name"`
+	Units     int64  `json:"units"`
+	Sales     int64  `json:"sales"`
+}
+
+type salesBucket struct {
+	Start  time.Time `json:"start"`
+	Sales  int64     `json:"sales"`
+	Orders int       `json:"orders"`
+}
+
+func BoothStatsHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boothID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		if !isAdmin(c) && !isHostOfBooth(c, boothID, client) {
+			return apierror.Forbidden
+		}
+
+		from, to, err := parseWindow(c, 24*time.Hour)
+		if err != nil {
+			return err
+		}
+
+		inWindow := transaction.And(
+			transaction.BoothIDEQ(boothID),
+			transaction.TimestampGTE(from),
+			transaction.TimestampLT(to),
+		)
+
+		// 상태별 합계로 총매출과 환불을 한 번에 구한다
+		var byStatus []struct {
+			Status string `json:"status"`
+			Orders int    `json:"orders"`
+			Amount int64  `json:"amount"`
+		}
+		err = client.Transaction.
+			Query().
+			Where(inWindow).
+			GroupBy(transaction.FieldStatus).
+			Aggregate(ent.As(ent.Count(), "orders"), ent.As(ent.Sum(transaction.FieldAmount), "amount")).
+			Scan(c.Context(), &byStatus)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		var gross, refunds int64
+		var orders, refundedOrders int
+		for _, s := range byStatus {
+			switch s.Status {
+			case "SUCCESS":
+				gross += s.Amount
+				orders += s.Orders
+			case "REFUNDED":
+				gross += s.Amount
+				refunds += s.Amount
+				refundedOrders += s.Orders
+			}
+		}
+
+		net := gross - refunds
+		var average int64
+		if orders > 0 {
+			average = net / int64(orders)
+		}
+
+		products, err := productStats(c, client, boothID, inWindow)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		hourly, err := salesSeries(c, client, transaction.And(inWindow, transaction.StatusEQ("SUCCESS")), from, to, time.Hour)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(fiber.Map{
+			"booth_id":            boothID,
+			"from":                from,
+			"to":                  to,
+			"gross_sales":         gross,
+			"refunds":             refunds,
+			"net_sales":           net,
+			"orders":              orders,
+			"refunded_orders":     refundedOrders,
+			"average_order_value": average,
+			"products":            products,
+			"hourly":              hourly,
+		})
+	}
+}
+
+func productStats(c *fiber.Ctx, client *ent.Client, boothID int, inWindow predicate.Transaction) ([]productStat, error) {
+	var rows []struct {
+		ProductID int   `sql:"product_transactions"` // product_id 의 실제 컬럼명
+		Units     int64 `json:"units"`
+		Sales     int64 `json:"sales"`
+	}
+	err := client.Transaction.
+		Query().
+		Where(inWindow, transaction.StatusEQ("SUCCESS")).
+		GroupBy(transaction.FieldProductID).
+		Aggregate(ent.As(ent.Sum(transaction.FieldQuantity), "units"), ent.As(ent.Sum(transaction.FieldAmount), "sales")).
+		Scan(c.Context(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := client.Product.
+		Query().
+		Where(product.HasBoothWith(booth.IDEQ(boothID))).
+		Order(ent.Asc(product.FieldID)).
+		All(c.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	sold := make(map[int]productStat, len(rows))
+	for _, r := range rows {
+		sold[r.ProductID] = productStat{ProductID: r.ProductID, Units: r.Units, Sales: r.Sales}
+	}
+
+	// 팔리지 않은 상품도 0 으로 보여준다
+	stats := make([]productStat, 0, len(ps))
+	for _, p := range ps {
+		s := sold[p.ID]
+		s.ProductID = p.ID
+		s.Name = p.Name
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+type bucketRow struct {
+	Bucket int64 `json:"bucket"`
+	Amount int64 `json:"amount"`
+	Count  int   `json:"count"`
+}
+
+// salesSeries 는 거래 금액과 건수를 size 단위 구간으로 묶는다.
+// 거래가 없는 구간은 0 으로 채워 연속된 시계열을 돌려준다.
+func salesSeries(c *fiber.Ctx, client *ent.Client, pred predicate.Transaction, from, to time.Time, size time.Duration) ([]salesBucket, error) {
+	rows, err := transactionBuckets(c, client, pred, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []salesBucket
+	for t := from.Truncate(size); t.Before(to); t = t.Add(size) {
+		r := rows[t.Unix()]
+		series = append(series, salesBucket{Start: t, Sales: r.Amount, Orders: r.Count})
+	}
+	return series, nil
+}
+
+// transactionBuckets 는 거래를 size 단위 구간별로 DB 에서 집계해 구간 시작 시각(유닉스 초)으로 묶는다.
+func transactionBuckets(c *fiber.Ctx, client *ent.Client, pred predicate.Transaction, size time.Duration) (map[int64]bucketRow, error) {
+	var rows []bucketRow
+	err := client.Transaction.
+		Query().
+		Where(pred).
+		Modify(func(s *sql.Selector) {
+			bucket := timeBucket(s, s.C(transaction.FieldTimestamp), size)
+			s.Select(
+				sql.As(bucket, "bucket"),
+				sql.As(sql.Sum(s.C(transaction.FieldAmount)), "amount"),
+				sql.As(sql.Count("*"), "count"),
+			).GroupBy(bucket)
+		}).
+		Scan(c.Context(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketsByStart(rows), nil
+}
+
+func bucketsByStart(rows []bucketRow) map[int64]bucketRow {
+	m := make(map[int64]bucketRow, len(rows))
+	for _, r := range rows {
+		m[r.Bucket] = r
+	}
+	return m
+}
+
+// timeBucket 은 시간 컬럼을 size 단위로 내린 유닉스 초를 구하는 식을 방언에 맞게 만든다.
+func timeBucket(s *sql.Selector, column string, size time.Duration) string {
+	secs := int64(size / time.Second)
+
+	switch s.Dialect() {
+	case dialect.SQLite:
+		return fmt.Sprintf("(CAST(strftime('%%s', %s) AS INTEGER) / %d * %d)", column, secs, secs)
+	case dialect.Postgres:
+		return fmt.Sprintf("CAST(FLOOR(EXTRACT(EPOCH FROM %s) / %d) * %d AS BIGINT)", column, secs, secs)
+	default:
+		return fmt.Sprintf("CAST(FLOOR(UNIX_TIMESTAMP(%s) / %d) * %d AS SIGNED)", column, secs, secs)
+	}
+}
+
+// parseWindow 는 from/to 쿼리 파라미터를 읽는다. 없으면 지금부터 span 만큼 이전까지로 본다.
+func parseWindow(c *fiber.Ctx, span time.Duration) (time.Time, time.Time, error) {
+	to := time.Now()
+	toParam, err := queryTime(c, "to")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if toParam != nil {
+		to = *toParam
+	}
+
+	from := to.Add(-span)
+	fromParam, err := queryTime(c, "from")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if fromParam != nil {
+		from = *fromParam
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, apierror.InvalidParam("from")
+	}
+	if to.Sub(from) > maxStatsWindow {
+		return time.Time{}, time.Time{}, apierror.WindowTooLarge
+	}
+
+	return from, to, nil
+}
@@ -1,17 +1,32 @@
 package handler
 
 import (
+	"entgo.io/ent/dialect/sql"
+	"errors"
 	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"somapay-backend/config"
 	"somapay-backend/ent"
+	"somapay-backend/ent/booth"
+	"somapay-backend/ent/predicate"
 	"somapay-backend/ent/product"
 	"somapay-backend/ent/transaction"
+	"somapay-backend/ent/user"
+	"somapay-backend/events"
+	"somapay-backend/metrics"
+	"somapay-backend/outbox"
 	"strconv"
+	"time"
 )
 
-func CreateTransactionHandler(client *ent.Client) fiber.Handler {
+func CreateTransactionHandler(client *ent.Client, broker *events.Broker, limits config.PaymentConfig) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		u := c.Locals("user").(*ent.User)
 
+		// 실패 사유별 결제 지표. 성공하면 비운다
+		failure := "internal_error"
+		defer func() { metrics.RecordPayment(failure) }()
+
 		var req struct {
 			ProductID int    `json:"product_id"`
 			Quantity  int    `json:"quantity"`
@@ -19,23 +34,29 @@ func CreateTransactionHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			failure = "invalid_request"
+			return apierror.InvalidBody
+		}
+
+		if req.Quantity <= 0 {
+			failure = "invalid_quantity"
+			return apierror.InvalidField("quantity")
+		}
+		if limits.MaxQuantity > 0 && req.Quantity > limits.MaxQuantity {
+			failure = "quantity_limit"
+			return apierror.QuantityLimitExceeded
 		}
 
 		if u.Pin != req.PIN {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "invalid pin"})
+			failure = "invalid_pin"
+			return apierror.InvalidPIN
 		}
 
 		tx, err := client.Tx(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "tx begin failed"})
+			return apierror.Internal.Wrap(err)
 		}
-
-		defer func() {
-			if err != nil {
-				_ = tx.Rollback()
-			}
-		}()
+		defer func() { _ = tx.Rollback() }()
 
 		p, err := tx.Product.
 			Query().
@@ -43,21 +64,24 @@ func CreateTransactionHandler(client *ent.Client) fiber.Handler {
 			WithBooth().
 			Only(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "product not found"})
+			failure = "product_not_found"
+			return apierror.ProductNotFound
 		}
 
 		total := p.Price * int64(req.Quantity)
-
-		if u.Point < total {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "not enough balance"})
+		if limits.MaxTransactionAmount > 0 && total > limits.MaxTransactionAmount {
+			failure = "amount_limit"
+			return apierror.AmountLimitExceeded
 		}
 
 		// User 포인트 차감
-		_, err = tx.User.UpdateOne(u).
-			AddPoint(-total).
-			Save(c.Context())
+		buyer, err := debitPoints(c.Context(), tx, u.ID, total)
+		if errors.Is(err, errInsufficientBalance) {
+			failure = "not_enough_balance"
+			return apierror.InsufficientBalance
+		}
 		if err != nil {
-			return err
+			return apierror.Internal.Wrap(err)
 		}
 
 		boothID, err := tx.Product.
@@ -66,7 +90,15 @@ func CreateTransactionHandler(client *ent.Client) fiber.Handler {
 			QueryBooth().
 			OnlyID(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot get booth id"})
+			return apierror.Internal.Wrap(err)
+		}
+
+		// 부스 카운터를 올려 주문 번호를 매긴다. 같은 부스의 주문은 이 행 잠금으로 순서가 정해진다
+		b, err := tx.Booth.UpdateOneID(boothID).
+			AddOrderSeq(1).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
 		}
 
 		t, err := tx.Transaction.
@@ -77,15 +109,34 @@ func CreateTransactionHandler(client *ent.Client) fiber.Handler {
 			SetQuantity(int64(req.Quantity)).
 			SetAmount(total).
 			SetStatus("SUCCESS").
+			SetOrderNumber(b.OrderSeq).
 			Save(c.Context())
 		if err != nil {
-			return err
+			return apierror.Internal.Wrap(err)
+		}
+
+		if err = outbox.Record(c.Context(), tx, outbox.TransactionCreated, t); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		hostID, err := tx.Booth.
+			Query().
+			Where(booth.IDEQ(boothID)).
+			QueryUser().
+			OnlyID(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
 		}
 
 		if err = tx.Commit(); err != nil {
-			return err
+			return apierror.Internal.Wrap(err)
 		}
 
+		failure = ""
+
+		publishTransaction(broker, "transaction.created", t, hostID)
+		publishBalanceChanged(broker, buyer)
+
 		return c.JSON(t)
 	}
 }
@@ -95,7 +146,7 @@ func GetTransactionHandler(client *ent.Client) fiber.Handler {
 		user := c.Locals("user").(*ent.User)
 		txID, err := strconv.Atoi(c.Params("id"))
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid transaction id"})
+			return apierror.InvalidParam("id")
 		}
 
 		t, err := client.Transaction.
@@ -107,13 +158,13 @@ func GetTransactionHandler(client *ent.Client) fiber.Handler {
 			}).
 			Only(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "transaction not found"})
+			return apierror.TransactionNotFound
 		}
 
 		if user.Role != "ADMIN" &&
 			user.ID != t.Edges.User.ID &&
 			!(user.Role == "HOST" && user.ID == t.Edges.Booth.Edges.User.ID) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		return c.JSON(t)
@@ -122,45 +173,210 @@ func GetTransactionHandler(client *ent.Client) fiber.Handler {
 
 func ListTransactionsHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		user := c.Locals("user").(*ent.User)
+		preds, err := transactionFilters(c)
+		if err != nil {
+			return err
+		}
 
-		query := client.Transaction.Query().WithUser().WithBooth(func(q *ent.BoothQuery) { q.WithUser() })
+		limit, err := parseLimit(c)
+		if err != nil {
+			return err
+		}
 
-		var ts []*ent.Transaction
+		sort, err := parseTransactionSort(c)
+		if err != nil {
+			return err
+		}
 
-		switch user.Role {
-		case "ADMIN":
-			var err error
-			ts, err = query.All(c.Context())
-			if err != nil {
-				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
-			}
+		query := client.Transaction.Query().Where(preds...)
+
+		total, err := query.Clone().Count(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
 
-		case "HOST":
-			allTx, err := query.All(c.Context())
+		if cursor := c.Query("cursor"); cursor != "" {
+			after, err := sort.after(cursor)
 			if err != nil {
-				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
+				return err
 			}
+			query.Where(after)
+		}
 
-			for _, t := range allTx {
-				if (t.Edges.Booth != nil && t.Edges.Booth.Edges.User.ID == user.ID) || t.Edges.User.ID == user.ID {
-					ts = append(ts, t)
-				}
-			}
+		ts, err := query.
+			Order(sort.order()...).
+			Limit(limit + 1).
+			WithUser().
+			WithProduct().
+			WithBooth(func(q *ent.BoothQuery) { q.WithUser() }).
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
 
-		default:
-			allTx, err := query.All(c.Context())
-			if err != nil {
-				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "query failed"})
-			}
+		res := page[*ent.Transaction]{Items: ts, Total: &total}
+		if len(ts) > limit {
+			res.Items = ts[:limit]
+			res.NextCursor = sort.cursor(ts[limit-1])
+		}
 
-			for _, t := range allTx {
-				if t.Edges.User.ID == user.ID {
-					ts = append(ts, t)
-				}
-			}
+		return c.JSON(res)
+	}
+}
+
+// transactionFilters 는 목록 조회 쿼리 파라미터를 조건으로 변환한다.
+// 관리자가 아니면 자기 거래와 (호스트라면) 자기 부스의 거래로 범위를 제한한다.
+func transactionFilters(c *fiber.Ctx) ([]predicate.Transaction, error) {
+	u := c.Locals("user").(*ent.User)
+
+	var preds []predicate.Transaction
+
+	switch u.Role {
+	case "ADMIN":
+	case "HOST":
+		preds = append(preds, transaction.Or(
+			transaction.UserIDEQ(u.ID),
+			transaction.HasBoothWith(booth.HasUserWith(user.IDEQ(u.ID))),
+		))
+	default:
+		preds = append(preds, transaction.UserIDEQ(u.ID))
+	}
+
+	if status := c.Query("status"); status != "" {
+		preds = append(preds, transaction.StatusEQ(status))
+	}
+
+	filters := []struct {
+		key  string
+		pred func(int) predicate.Transaction
+	}{
+		{"booth_id", transaction.BoothIDEQ},
+		{"product_id", transaction.ProductIDEQ},
+		{"user_id", transaction.UserIDEQ},
+	}
+	for _, f := range filters {
+		v, err := queryInt(c, f.key)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			preds = append(preds, f.pred(*v))
+		}
+	}
+
+	from, err := queryTime(c, "from")
+	if err != nil {
+		return nil, err
+	}
+	if from != nil {
+		preds = append(preds, transaction.TimestampGTE(*from))
+	}
+
+	to, err := queryTime(c, "to")
+	if err != nil {
+		return nil, err
+	}
+	if to != nil {
+		preds = append(preds, transaction.TimestampLT(*to))
+	}
+
+	return preds, nil
+}
+
+// transactionSort 는 정렬 기준과 그에 맞는 커서 조건을 함께 다룬다.
+// 같은 값끼리는 id 로 순서를 고정해 페이지 사이에 빠지거나 겹치는 행이 없게 한다.
+type transactionSort struct {
+	field string
+	desc  bool
+}
+
+func parseTransactionSort(c *fiber.Ctx) (transactionSort, error) {
+	s := transactionSort{field: c.Query("sort", transaction.FieldTimestamp)}
+	switch s.field {
+	case transaction.FieldTimestamp, transaction.FieldAmount, transaction.FieldID:
+	default:
+		return s, apierror.InvalidParam("sort")
+	}
+
+	switch c.Query("order", "desc") {
+	case "desc":
+		s.desc = true
+	case "asc":
+	default:
+		return s, apierror.InvalidParam("order")
+	}
+
+	return s, nil
+}
+
+func (s transactionSort) order() []transaction.OrderOption {
+	dir := sql.OrderAsc()
+	if s.desc {
+		dir = sql.OrderDesc()
+	}
+
+	if s.field == transaction.FieldID {
+		return []transaction.OrderOption{transaction.ByID(dir)}
+	}
+	return []transaction.OrderOption{sql.OrderByField(s.field, dir).ToFunc(), transaction.ByID(dir)}
+}
+
+func (s transactionSort) cursor(t *ent.Transaction) string {
+	switch s.field {
+	case transaction.FieldTimestamp:
+		return encodeCursor(t.Timestamp.Format(time.RFC3339Nano), strconv.Itoa(t.ID))
+	case transaction.FieldAmount:
+		return encodeCursor(strconv.FormatInt(t.Amount, 10), strconv.Itoa(t.ID))
+	default:
+		return encodeCursor(strconv.Itoa(t.ID))
+	}
+}
+
+func (s transactionSort) after(cursor string) (predicate.Transaction, error) {
+	if s.field == transaction.FieldID {
+		id, err := decodeIDCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if s.desc {
+			return transaction.IDLT(id), nil
 		}
+		return transaction.IDGT(id), nil
+	}
+
+	parts, err := decodeCursor(cursor, 2)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, errInvalidCursor
+	}
 
-		return c.JSON(ts)
+	idAfter := transaction.IDGT(id)
+	if s.desc {
+		idAfter = transaction.IDLT(id)
+	}
+
+	switch s.field {
+	case transaction.FieldTimestamp:
+		ts, err := time.Parse(time.RFC3339Nano, parts[0])
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		if s.desc {
+			return transaction.Or(transaction.TimestampLT(ts), transaction.And(transaction.TimestampEQ(ts), idAfter)), nil
+		}
+		return transaction.Or(transaction.TimestampGT(ts), transaction.And(transaction.TimestampEQ(ts), idAfter)), nil
+
+	default:
+		amount, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		if s.desc {
+			return transaction.Or(transaction.AmountLT(amount), transaction.And(transaction.AmountEQ(amount), idAfter)), nil
+		}
+		return transaction.Or(transaction.AmountGT(amount), transaction.And(transaction.AmountEQ(amount), idAfter)), nil
 	}
 }
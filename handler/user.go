@@ -2,14 +2,16 @@ package handler
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
 	"somapay-backend/ent"
+	"somapay-backend/outbox"
 	"strconv"
 )
 
 func CreateUserHandler(client *ent.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if !isAdmin(c) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		var req struct {
@@ -20,15 +22,21 @@ func CreateUserHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		hashedPass, err := hashPassword(req.Password)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "internal error"})
+			return apierror.Internal.Wrap(err)
 		}
 
-		u, err := client.User.
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		u, err := tx.User.
 			Create().
 			SetUsername(req.Username).
 			SetPassword(hashedPass).
@@ -38,7 +46,20 @@ func CreateUserHandler(client *ent.Client) fiber.Handler {
 			Save(c.Context())
 
 		if err != nil {
-			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "duplicated"})
+			return apierror.UsernameTaken
+		}
+
+		err = outbox.Record(c.Context(), tx, outbox.UserCreated, fiber.Map{
+			"id":       u.ID,
+			"username": u.Username,
+			"role":     u.Role,
+		})
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(u)
@@ -51,16 +72,16 @@ func GetUserHandler(client *ent.Client) fiber.Handler {
 		targetID := int(targetID64)
 
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		if !isAdmin(c) && !isSelf(c, targetID) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		u, err := client.User.Get(c.Context(), targetID)
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+			return apierror.UserNotFound
 		}
 
 		return c.JSON(u)
@@ -73,11 +94,11 @@ func UpdateUserHandler(client *ent.Client) fiber.Handler {
 		targetID := int(targetID64)
 
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+			return apierror.InvalidParam("id")
 		}
 
 		if !isAdmin(c) && !isSelf(c, targetID) {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+			return apierror.Forbidden
 		}
 
 		var req struct {
@@ -86,7 +107,7 @@ func UpdateUserHandler(client *ent.Client) fiber.Handler {
 		}
 
 		if err := c.BodyParser(&req); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+			return apierror.InvalidBody
 		}
 
 		q := client.User.UpdateOneID(targetID)
@@ -100,7 +121,7 @@ func UpdateUserHandler(client *ent.Client) fiber.Handler {
 
 		u, err := q.Save(c.Context())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "update failed"})
+			return apierror.Internal.Wrap(err)
 		}
 
 		return c.JSON(u)
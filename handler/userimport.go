@@ -0,0 +1,456 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"html/template"
+	"io"
+	"regexp"
+	"runtime"
+	"somapay-backend/apierror"
+	"somapay-backend/credential"
+	"somapay-backend/ent"
+	"somapay-backend/ent/user"
+	"somapay-backend/export"
+	"somapay-backend/outbox"
+	"somapay-backend/storage"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 한 번에 등록할 수 있는 최대 인원
+const maxRosterRows = 2000
+
+// CreateBulk 한 번에 넣는 행 수. DB 별 바인드 변수 개수 제한을 넘지 않게 나눈다.
+const rosterBatchSize = 500
+
+var rosterColumnNames = map[string][]string{
+	"student_number": {"student_number", "학번", "아이디"},
+	"name":           {"name", "이름", "성명"},
+	"grade":          {"grade", "학년"},
+	"class":          {"class", "반"},
+	"role":           {"role", "역할", "권한"},
+}
+
+// 헤더가 없을 때의 열 순서
+var rosterColumnOrder = []string{"student_number", "name", "grade", "class", "role"}
+
+var studentNumberPattern = regexp.MustCompile(`^[0-9A-Za-z]{1,32}$`)
+
+type rosterRow struct {
+	Line          int    `json:"line"`
+	StudentNumber string `json:"student_number"`
+	Name          string `json:"name"`
+	Grade         int    `json:"grade"`
+	Class         int    `json:"class"`
+	Role          string `json:"role"`
+}
+
+type rosterError struct {
+	Line   int    `json:"line"`
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ImportUsersHandler 는 학생 명단 CSV(학번, 이름, 학년, 반, 역할)로 계정을 한꺼번에 만든다.
+// dry_run=true 면 검사 결과만 돌려준다. 오류가 하나라도 있으면 아무 계정도 만들지 않는다.
+// 만든 계정의 초기 비밀번호와 PIN 은 계정표로 한 번만 내려받을 수 있다.
+func ImportUsersHandler(client *ent.Client, sheets *storage.SheetStore) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return apierror.MissingFile
+		}
+
+		f, err := fh.Open()
+		if err != nil {
+			return apierror.InvalidFile
+		}
+		defer f.Close()
+
+		rows, rowErrors, err := parseRoster(f)
+		if err != nil {
+			return apierror.InvalidFile.WithDetails(fiber.Map{"reason": err.Error()})
+		}
+
+		existing, err := existingUsernames(c.Context(), client, rows)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		for _, r := range rows {
+			if existing[r.StudentNumber] {
+				rowErrors = append(rowErrors, rosterError{Line: r.Line, Field: "student_number", Reason: "already registered"})
+			}
+		}
+		sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Line < rowErrors[j].Line })
+
+		preview := fiber.Map{
+			"valid":  len(rowErrors) == 0,
+			"total":  len(rows),
+			"rows":   rows,
+			"errors": rowErrors,
+		}
+		if c.QueryBool("dry_run") {
+			return c.JSON(preview)
+		}
+		if len(rowErrors) > 0 {
+			return apierror.InvalidRoster.WithDetails(preview)
+		}
+
+		sheet, err := createRosterUsers(c.Context(), client, rows)
+		if ent.IsConstraintError(err) {
+			return apierror.UsernameTaken
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		admin := c.Locals("user").(*ent.User)
+		sheet.OwnerID = admin.ID
+		sheet.CreatedAt = time.Now()
+		id := sheets.Put(sheet)
+
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+			"created": len(rows),
+			"sheet": fiber.Map{
+				"id":         id,
+				"expires_at": sheets.ExpiresAt(sheet),
+				"csv_url":    "/users/import/sheets/" + id + "?format=csv",
+				"html_url":   "/users/import/sheets/" + id + "?format=html",
+			},
+		})
+	}
+}
+
+// DownloadCredentialSheetHandler 는 계정표를 한 번만 내려준다. 형식과 관계없이 첫 요청에서 지워진다.
+func DownloadCredentialSheetHandler(sheets *storage.SheetStore) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		format := c.Query("format", "csv")
+		if format != "csv" && format != "html" {
+			return apierror.InvalidParam("format")
+		}
+
+		admin := c.Locals("user").(*ent.User)
+		sheet, ok := sheets.Take(c.Params("sheet_id"), admin.ID)
+		if !ok {
+			return apierror.SheetNotFound
+		}
+
+		c.Set(fiber.HeaderCacheControl, "no-store")
+		filename := fmt.Sprintf("credentials-%s.%s", sheet.CreatedAt.Format("20060102-150405"), format)
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+		if format == "html" {
+			c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+			return credentialSheetTemplate.Execute(c.Response().BodyWriter(), sheet)
+		}
+
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		w, err := export.NewCSVWriter(c.Response().BodyWriter())
+		if err != nil {
+			return err
+		}
+		if err := w.Write([]any{"student_number", "name", "grade", "class", "role", "password", "pin"}); err != nil {
+			return err
+		}
+		for _, r := range sheet.Rows {
+			if err := w.Write([]any{r.Username, r.Name, r.Grade, r.Class, r.Role, r.Password, r.Pin}); err != nil {
+				return err
+			}
+		}
+		return w.Close()
+	}
+}
+
+func parseRoster(r io.Reader) ([]rosterRow, []rosterError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, errors.New("invalid csv")
+	}
+	if len(records) == 0 {
+		return nil, nil, errors.New("empty csv")
+	}
+
+	records[0][0] = strings.TrimPrefix(records[0][0], "\uFEFF")
+
+	// 첫 행에 알려진 열 이름이 있으면 헤더로 보고, 없으면 정해진 순서로 읽는다
+	cols := make(map[string]int)
+	for i, name := range rosterColumnOrder {
+		cols[name] = i
+	}
+	start := 0
+	if findRosterColumn(records[0], "student_number") >= 0 || findRosterColumn(records[0], "name") >= 0 {
+		for key := range rosterColumnNames {
+			cols[key] = findRosterColumn(records[0], key)
+		}
+		if cols["student_number"] < 0 || cols["name"] < 0 {
+			return nil, nil, errors.New("missing student_number or name column")
+		}
+		start = 1
+	}
+
+	if len(records)-start > maxRosterRows {
+		return nil, nil, fmt.Errorf("too many rows (max %d)", maxRosterRows)
+	}
+
+	rows := []rosterRow{}
+	rowErrors := []rosterError{}
+	seen := make(map[string]int)
+
+	for i := start; i < len(records); i++ {
+		rec := records[i]
+		line := i + 1
+
+		// 빈 줄은 건너뛴다
+		if strings.TrimSpace(strings.Join(rec, "")) == "" {
+			continue
+		}
+
+		row := rosterRow{
+			Line:          line,
+			StudentNumber: strings.TrimSpace(cellAt(rec, cols["student_number"])),
+			Name:          strings.TrimSpace(cellAt(rec, cols["name"])),
+			Role:          strings.ToUpper(strings.TrimSpace(cellAt(rec, cols["role"]))),
+		}
+		if row.Role == "" {
+			row.Role = "USER"
+		}
+
+		fail := func(field, reason string) {
+			rowErrors = append(rowErrors, rosterError{Line: line, Field: field, Reason: reason})
+		}
+
+		if !studentNumberPattern.MatchString(row.StudentNumber) {
+			fail("student_number", "invalid student number")
+		} else if first, dup := seen[row.StudentNumber]; dup {
+			fail("student_number", fmt.Sprintf("duplicated in line %d", first))
+		} else {
+			seen[row.StudentNumber] = line
+		}
+		if row.Name == "" {
+			fail("name", "missing name")
+		}
+		if row.Grade, err = parseRosterNumber(cellAt(rec, cols["grade"])); err != nil {
+			fail("grade", "invalid grade")
+		}
+		if row.Class, err = parseRosterNumber(cellAt(rec, cols["class"])); err != nil {
+			fail("class", "invalid class")
+		}
+		if !roles[row.Role] {
+			fail("role", "invalid role")
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil, errors.New("no rows")
+	}
+	return rows, rowErrors, nil
+}
+
+func findRosterColumn(header []string, key string) int {
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		for _, name := range rosterColumnNames[key] {
+			if h == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseRosterNumber 는 "2", "2학년", "3반" 같은 값을 받는다. 비어 있으면 0 이다.
+func parseRosterNumber(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "학년"), "반")
+	if s == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n <= 0 {
+		return 0, errors.New("invalid number")
+	}
+	return n, nil
+}
+
+func existingUsernames(ctx context.Context, client *ent.Client, rows []rosterRow) (map[string]bool, error) {
+	names := make([]string, len(rows))
+	for i, r := range rows {
+		names[i] = r.StudentNumber
+	}
+
+	existing := make(map[string]bool)
+	for start := 0; start < len(names); start += rosterBatchSize {
+		end := min(start+rosterBatchSize, len(names))
+		found, err := client.User.
+			Query().
+			Where(user.UsernameIn(names[start:end]...)).
+			Select(user.FieldUsername).
+			Strings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range found {
+			existing[name] = true
+		}
+	}
+	return existing, nil
+}
+
+// createRosterUsers 는 모든 계정을 한 트랜잭션으로 만들고 초기 비밀번호가 담긴 계정표를 돌려준다.
+func createRosterUsers(ctx context.Context, client *ent.Client, rows []rosterRow) (*storage.CredentialSheet, error) {
+	sheet := &storage.CredentialSheet{Rows: make([]storage.CredentialRow, len(rows))}
+	passwords := make([]string, len(rows))
+	for i, r := range rows {
+		password, err := credential.RandomPassword()
+		if err != nil {
+			return nil, err
+		}
+		pin, err := credential.RandomPIN()
+		if err != nil {
+			return nil, err
+		}
+		passwords[i] = password
+		sheet.Rows[i] = storage.CredentialRow{
+			Username: r.StudentNumber,
+			Name:     r.Name,
+			Grade:    r.Grade,
+			Class:    r.Class,
+			Role:     r.Role,
+			Password: password,
+			Pin:      pin,
+		}
+	}
+
+	hashed, err := hashPasswords(passwords)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for start := 0; start < len(rows); start += rosterBatchSize {
+		end := min(start+rosterBatchSize, len(rows))
+
+		builders := make([]*ent.UserCreate, 0, end-start)
+		for i := start; i < end; i++ {
+			r := sheet.Rows[i]
+			b := tx.User.
+				Create().
+				SetUsername(r.Username).
+				SetPassword(hashed[i]).
+				SetPin(r.Pin).
+				SetRole(r.Role).
+				SetPoint(0).
+				SetName(r.Name)
+			if r.Grade > 0 {
+				b.SetGrade(r.Grade)
+			}
+			if r.Class > 0 {
+				b.SetClass(r.Class)
+			}
+			builders = append(builders, b)
+		}
+
+		us, err := tx.User.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range us {
+			err := outbox.Record(ctx, tx, outbox.UserCreated, fiber.Map{
+				"id":       u.ID,
+				"username": u.Username,
+				"role":     u.Role,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
+// hashPasswords 는 수백 명을 차례로 해시하면 요청이 길어지므로 CPU 수만큼 나눠 해시한다.
+func hashPasswords(passwords []string) ([]string, error) {
+	hashed := make([]string, len(passwords))
+	errs := make([]error, len(passwords))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.NumCPU())
+	for i, p := range passwords {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			hashed[i], errs[i] = credential.HashGeneratedPassword(p)
+		}()
+	}
+	wg.Wait()
+
+	return hashed, errors.Join(errs...)
+}
+
+// 잘라서 나눠 줄 수 있도록 한 명씩 칸으로 나눈 인쇄용 계정표
+var credentialSheetTemplate = template.Must(template.New("sheet").Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="utf-8">
+<title>소마페이 계정표</title>
+<style>
+  body { font-family: sans-serif; margin: 1cm; }
+  .grid { display: grid; grid-template-columns: repeat(3, 1fr); gap: 0; }
+  .card { border: 1px dashed #999; padding: 0.4cm; page-break-inside: avoid; }
+  .card h2 { margin: 0 0 0.2cm; font-size: 13pt; }
+  .card dl { margin: 0; display: grid; grid-template-columns: auto 1fr; gap: 0.1cm 0.3cm; font-size: 11pt; }
+  .card dd { margin: 0; font-family: monospace; font-size: 12pt; }
+  .note { font-size: 9pt; color: #555; margin-top: 0.2cm; }
+</style>
+</head>
+<body>
+<div class="grid">
+{{range .Rows}}
+  <div class="card">
+    <h2>{{if .Grade}}{{.Grade}}학년 {{end}}{{if .Class}}{{.Class}}반 {{end}}{{.Name}}</h2>
+    <dl>
+      <dt>학번</dt><dd>{{.Username}}</dd>
+      <dt>비밀번호</dt><dd>{{.Password}}</dd>
+      <dt>결제 PIN</dt><dd>{{.Pin}}</dd>
+    </dl>
+    <div class="note">로그인 후 비밀번호를 바꿔 주세요.</div>
+  </div>
+{{end}}
+</div>
+</body>
+</html>
+`))
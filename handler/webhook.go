@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/gofiber/fiber/v2"
+	"net/url"
+	"somapay-backend/apierror"
+	"somapay-backend/ent"
+	"somapay-backend/ent/webhook"
+	"somapay-backend/ent/webhookdelivery"
+	"somapay-backend/outbox"
+	"strconv"
+	"time"
+)
+
+var deliveryStatuses = map[string]bool{
+	"PENDING":   true,
+	"DELIVERED": true,
+	"DEAD":      true,
+}
+
+func CreateWebhookHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		var req struct {
+			URL    string   `json:"url"`
+			Events []string `json:"events"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		if !validWebhookURL(req.URL) {
+			return apierror.InvalidField("url")
+		}
+		if !validWebhookEvents(req.Events) {
+			return apierror.InvalidField("events")
+		}
+
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		w, err := client.Webhook.
+			Create().
+			SetURL(req.URL).
+			SetEvents(req.Events).
+			SetSecret(secret).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		// 서명 키는 생성할 때 한 번만 보여준다
+		return c.JSON(fiber.Map{"webhook": w, "secret": secret})
+	}
+}
+
+func ListWebhooksHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		ws, err := client.Webhook.
+			Query().
+			Order(ent.Asc(webhook.FieldID)).
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(ws)
+	}
+}
+
+func UpdateWebhookHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		webhookID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		var req struct {
+			URL          *string   `json:"url"`
+			Events       *[]string `json:"events"`
+			Enabled      *bool     `json:"enabled"`
+			RotateSecret bool      `json:"rotate_secret"`
+		}
+		if err := c.BodyParser(&req); err != nil {
+			return apierror.InvalidBody
+		}
+
+		q := client.Webhook.UpdateOneID(webhookID)
+
+		if req.URL != nil {
+			if !validWebhookURL(*req.URL) {
+				return apierror.InvalidField("url")
+			}
+			q.SetURL(*req.URL)
+		}
+		if req.Events != nil {
+			if !validWebhookEvents(*req.Events) {
+				return apierror.InvalidField("events")
+			}
+			q.SetEvents(*req.Events)
+		}
+		if req.Enabled != nil {
+			q.SetEnabled(*req.Enabled)
+		}
+
+		var secret string
+		if req.RotateSecret {
+			secret, err = newWebhookSecret()
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+			q.SetSecret(secret)
+		}
+
+		w, err := q.Save(c.Context())
+		if ent.IsNotFound(err) {
+			return apierror.WebhookNotFound
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if secret != "" {
+			return c.JSON(fiber.Map{"webhook": w, "secret": secret})
+		}
+		return c.JSON(fiber.Map{"webhook": w})
+	}
+}
+
+func DeleteWebhookHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		webhookID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		tx, err := client.Tx(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		// 전송 기록은 웹훅에 묶여 있으므로 함께 지운다
+		_, err = tx.WebhookDelivery.
+			Delete().
+			Where(webhookdelivery.HasWebhookWith(webhook.IDEQ(webhookID))).
+			Exec(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		err = tx.Webhook.DeleteOneID(webhookID).Exec(c.Context())
+		if ent.IsNotFound(err) {
+			return apierror.WebhookNotFound
+		}
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// ListWebhookDeliveriesHandler 는 전송 기록을 최신순으로 보여준다.
+// status=DEAD 로 재시도를 모두 실패한 전송만 모아 볼 수 있다.
+func ListWebhookDeliveriesHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		limit, err := parseLimit(c)
+		if err != nil {
+			return err
+		}
+
+		query := client.WebhookDelivery.Query()
+
+		if status := c.Query("status"); status != "" {
+			if !deliveryStatuses[status] {
+				return apierror.InvalidParam("status")
+			}
+			query.Where(webhookdelivery.StatusEQ(status))
+		}
+
+		webhookID, err := queryInt(c, "webhook_id")
+		if err != nil {
+			return err
+		}
+		if webhookID != nil {
+			query.Where(webhookdelivery.HasWebhookWith(webhook.IDEQ(*webhookID)))
+		}
+
+		if cursor := c.Query("cursor"); cursor != "" {
+			lastID, err := decodeIDCursor(cursor)
+			if err != nil {
+				return err
+			}
+			query.Where(webhookdelivery.IDLT(lastID))
+		}
+
+		ds, err := query.
+			Order(ent.Desc(webhookdelivery.FieldID)).
+			Limit(limit + 1).
+			WithEvent().
+			WithWebhook().
+			All(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		res := page[*ent.WebhookDelivery]{Items: ds}
+		if len(ds) > limit {
+			res.Items = ds[:limit]
+			res.NextCursor = encodeCursor(strconv.Itoa(ds[limit-1].ID))
+		}
+
+		return c.JSON(res)
+	}
+}
+
+// RetryWebhookDeliveryHandler 는 DEAD 가 된 전송을 다시 대기열에 넣는다.
+func RetryWebhookDeliveryHandler(client *ent.Client) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !isAdmin(c) {
+			return apierror.Forbidden
+		}
+
+		deliveryID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return apierror.InvalidParam("id")
+		}
+
+		n, err := client.WebhookDelivery.
+			Update().
+			Where(webhookdelivery.IDEQ(deliveryID), webhookdelivery.StatusEQ("DEAD")).
+			SetStatus("PENDING").
+			SetAttempts(0).
+			SetNextAttemptAt(time.Now()).
+			Save(c.Context())
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+		if n == 0 {
+			exists, err := client.WebhookDelivery.Query().Where(webhookdelivery.IDEQ(deliveryID)).Exist(c.Context())
+			if err != nil {
+				return apierror.Internal.Wrap(err)
+			}
+			if !exists {
+				return apierror.DeliveryNotFound
+			}
+			return apierror.DeliveryNotDead
+		}
+
+		d, err := client.WebhookDelivery.Get(c.Context(), deliveryID)
+		if err != nil {
+			return apierror.Internal.Wrap(err)
+		}
+
+		return c.JSON(d)
+	}
+}
+
+func validWebhookURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func validWebhookEvents(evs []string) bool {
+	for _, e := range evs {
+		if !outbox.EventTypes[e] {
+			return false
+		}
+	}
+	return true
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
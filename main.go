@@ -2,62 +2,220 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"somapay-backend/audit"
+	"somapay-backend/cli"
+	"somapay-backend/config"
 	"somapay-backend/ent"
+	"somapay-backend/events"
 	"somapay-backend/handler"
+	"somapay-backend/metrics"
 	"somapay-backend/middleware"
+	"somapay-backend/outbox"
 	"somapay-backend/storage"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 )
 
 func main() {
-	app := fiber.New()
-	client := storage.GetClient()
-	sessionStore := storage.GetSessionStore()
+	configPath := flag.String("config", envOr("SOMAPAY_CONFIG", config.DefaultPath), "path to the JSON config file")
+	flag.Parse()
 
-	ctx := context.Background()
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	log.Printf("Loaded config: %s", cfg)
+
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(context.Background(), cfg, flag.Args()[1:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
+	// 관리 명령 (user, booth, points, sessions)
+	if cli.IsCommand(flag.Args()) {
+		client := storage.GetClient(cfg.Database)
+		audit.Register(client)
+
+		err := cli.Run(context.Background(), client, flag.Args(), os.Stdout)
+		_ = client.Close()
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
+	logger := newLogger(cfg.Log)
+	// 기존 log 패키지 출력도 같은 형식으로 남긴다
+	slog.SetDefault(logger)
+
+	app := fiber.New(fiber.Config{ErrorHandler: handler.ErrorHandler})
+	client := storage.GetClient(cfg.Database)
+	audit.Register(client)
+	sessionStore := storage.GetSessionStore(cfg.Session.IdleTimeout.Duration, cfg.Session.MaxLifetime.Duration)
+	sheetStore := storage.NewSheetStore(time.Hour)
+	broker := events.NewBroker(1000)
+	metrics.RegisterDB(storage.DB(), cfg.Database.Driver)
+	metrics.RegisterActiveSessions(sessionStore.Count)
+
+	drain := middleware.NewDrain()
+
+	// SIGTERM(배포) 이나 Ctrl+C 를 받으면 ctx 가 끝난다
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	setupCors(app)
-	setupRoutes(app, client, sessionStore, ctx)
+	// 웹훅 전송
+	var workers sync.WaitGroup
+	dispatcher := outbox.NewDispatcher(client, &http.Client{Timeout: 10 * time.Second})
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		dispatcher.Run(ctx, 5*time.Second)
+	}()
 
-	if err := app.Listen("0.0.0.0:8443"); err != nil {
+	// 만료된 세션과 계정표 정리
+	go func() {
+		for range time.Tick(time.Minute) {
+			sessionStore.Sweep()
+			sheetStore.Sweep()
+		}
+	}()
+
+	setupMiddleware(app, logger)
+	setupCors(app, cfg.AllowedOrigins)
+	setupRoutes(app, client, sessionStore, sheetStore, broker, drain, cfg, ctx)
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- app.Listen(cfg.Listen)
+	}()
+
+	select {
+	case err := <-listenErr:
 		log.Fatalf("Failed to start server: %v", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	log.Printf("Shutting down (timeout %s)", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout.Duration)
+	defer cancel()
+
+	// 이벤트 스트림은 끝나지 않는 요청이므로 구독을 먼저 닫아 끝낸다. 이후 발행은 무시된다
+	broker.Close()
+	// 처리 중인 요청과 내보내기 스트림을 먼저 끝낸 뒤 리스너를 닫는다
+	if err := drain.Wait(shutdownCtx); err != nil {
+		log.Printf("Gave up waiting for in-flight requests: %v", err)
+	}
+	if err := app.ShutdownWithContext(shutdownCtx); err != nil {
+		log.Printf("Failed to close connections: %v", err)
 	}
+
+	workers.Wait()
+	if err := client.Close(); err != nil {
+		log.Printf("Failed to close database: %v", err)
+	}
+	log.Printf("Server stopped")
 }
 
-func setupCors(app *fiber.App) {
+func envOr(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
+
+func newLogger(cfg config.LogConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: cfg.SlogLevel()}
+	if cfg.Format == "text" {
+		return slog.New(slog.NewTextHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+}
+
+// setupMiddleware 는 요청 ID, 지표, 접근 로그, 패닉 복구를 건다. 패닉도 500 으로 접근 로그에 남도록 복구가 가장 안쪽이다.
+func setupMiddleware(app *fiber.App, logger *slog.Logger) {
+	app.Use(middleware.RequestID())
+	app.Use(middleware.Metrics())
+	app.Use(middleware.AccessLog(logger))
+	app.Use(middleware.Recover(logger))
+}
+
+func setupCors(app *fiber.App, origins []string) {
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: "https://pay.gbsw.hs.kr",
+		AllowOrigins: strings.Join(origins, ","),
 		AllowMethods: "GET,POST,PUT,PATCH,DELETE,OPTIONS",
 		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
 	}))
 }
 
-func setupRoutes(app *fiber.App, client *ent.Client, sessionStore *storage.SessionStore, ctx context.Context) {
+func setupRoutes(app *fiber.App, client *ent.Client, sessionStore *storage.SessionStore, sheetStore *storage.SheetStore, broker *events.Broker, drain *middleware.Drain, cfg *config.Config, ctx context.Context) {
+
+	// Health Routes (종료 중에도 응답한다)
+	app.Get("/healthz", handler.HealthzHandler())
+	app.Get("/readyz", handler.ReadyzHandler(func(ctx context.Context) error {
+		if drain.Draining() {
+			return errors.New("server is shutting down")
+		}
+		return storage.Ready(ctx)
+	}))
+
+	// 이후 라우트는 종료할 때 끝나기를 기다린다
+	app.Use(drain.Handler())
 
 	// Index (Ping)
 	app.Get("/", func(c *fiber.Ctx) error {
 		return c.SendString("Hello, World!")
 	})
 
+	// API Docs
+	app.Get("/openapi.json", handler.OpenAPIHandler())
+	app.Get("/docs", handler.APIExplorerHandler())
+
 	// Login Route
 	app.Post("/login", handler.LoginHandler(client, sessionStore))
 
 	// Auth Middleware
 	auth := middleware.AuthMiddleware(client, sessionStore)
 
+	// Metrics Route (그룹 미들웨어는 경로 앞부분만 보므로 /me 그룹보다 먼저 등록한다)
+	networks, _ := cfg.Metrics.Networks()
+	app.Get("/metrics", middleware.TrustedNetwork(networks, auth), handler.MetricsHandler())
+
 	// User Routes
 	userGroup := app.Group("/users", auth)
 	userGroup.Post("/", handler.CreateUserHandler(client))
+	userGroup.Post("/import", handler.ImportUsersHandler(client, sheetStore))
+	userGroup.Get("/import/sheets/:sheet_id", handler.DownloadCredentialSheetHandler(sheetStore))
 	userGroup.Get("/:id", handler.GetUserHandler(client))
 	userGroup.Patch("/:id", handler.UpdateUserHandler(client))
+	userGroup.Post("/:id/adjustments", handler.CreateAdjustmentHandler(client, broker))
+	userGroup.Get("/:id/adjustments", handler.ListAdjustmentsHandler(client))
+
+	// Me Routes
+	meGroup := app.Group("/me", auth)
+	meGroup.Get("/", handler.MeHandler(client))
+	meGroup.Get("/activity", handler.MyActivityHandler(client))
 
 	// Booth Routes
 	boothGroup := app.Group("/booths", auth)
 	boothGroup.Post("/", handler.CreateBoothHandler(client))
 	boothGroup.Get("/", handler.ListBoothsHandler(client))
 	boothGroup.Get("/:id", handler.GetBoothHandler(client))
+	boothGroup.Get("/:id/stats", handler.BoothStatsHandler(client))
+	boothGroup.Get("/:id/orders", handler.BoothOrderQueueHandler(client))
 	boothGroup.Patch("/:id", handler.UpdateBoothHandler(client))
 	boothGroup.Delete("/:id", handler.DeleteBoothHandler(client))
 
@@ -72,14 +230,51 @@ func setupRoutes(app *fiber.App, client *ent.Client, sessionStore *storage.Sessi
 
 	// Charge Request Routes
 	chargeGroup := app.Group("/charge-requests", auth)
-	chargeGroup.Post("/", handler.CreateChargeRequestHandler(client))
+	chargeGroup.Post("/", handler.CreateChargeRequestHandler(client, broker, cfg.Payment))
 	chargeGroup.Get("/", handler.ListChargeRequestsHandler(client))
+	chargeGroup.Post("/batch", handler.BatchDecideChargeRequestsHandler(client, broker))
+	chargeGroup.Post("/bank-import", handler.ImportBankStatementHandler(client))
 	chargeGroup.Get("/:id", handler.GetChargeRequestHandler(client))
-	chargeGroup.Patch("/:id", handler.UpdateChargeRequestHandler(client))
+	chargeGroup.Patch("/:id", handler.UpdateChargeRequestHandler(client, broker))
+	chargeGroup.Post("/:id/cancel", handler.CancelChargeRequestHandler(client))
+
+	// Auto Approval Rule Routes
+	ruleGroup := app.Group("/auto-approval-rules", auth)
+	ruleGroup.Get("/", handler.ListAutoApprovalRulesHandler(client))
+	ruleGroup.Post("/", handler.CreateAutoApprovalRuleHandler(client))
+	ruleGroup.Patch("/:id", handler.UpdateAutoApprovalRuleHandler(client))
+	ruleGroup.Delete("/:id", handler.DeleteAutoApprovalRuleHandler(client))
+
+	// Event Stream Route
+	app.Get("/events", middleware.QueryTokenMiddleware(), auth, handler.EventStreamHandler(broker, drain))
+
+	// Webhook Routes
+	webhookGroup := app.Group("/webhooks", auth)
+	webhookGroup.Get("/", handler.ListWebhooksHandler(client))
+	webhookGroup.Post("/", handler.CreateWebhookHandler(client))
+	webhookGroup.Get("/deliveries", handler.ListWebhookDeliveriesHandler(client))
+	webhookGroup.Post("/deliveries/:id/retry", handler.RetryWebhookDeliveryHandler(client))
+	webhookGroup.Patch("/:id", handler.UpdateWebhookHandler(client))
+	webhookGroup.Delete("/:id", handler.DeleteWebhookHandler(client))
+
+	// Export Routes
+	exportGroup := app.Group("/exports", auth)
+	exportGroup.Get("/transactions", handler.ExportTransactionsHandler(client, drain))
+	exportGroup.Get("/charge-requests", handler.ExportChargeRequestsHandler(client, drain))
+	exportGroup.Get("/balances", handler.ExportBalancesHandler(client, drain))
+
+	// Analytics Routes
+	app.Get("/analytics", auth, handler.AnalyticsHandler(client))
+
+	// Audit Log Routes
+	app.Get("/audit-logs", auth, handler.ListAuditLogsHandler(client))
 
 	// Transaction Routes
 	transactionGroup := app.Group("/transactions", auth)
-	transactionGroup.Post("/", handler.CreateTransactionHandler(client))
+	transactionGroup.Post("/", handler.CreateTransactionHandler(client, broker, cfg.Payment))
 	transactionGroup.Get("/", handler.ListTransactionsHandler(client))
 	transactionGroup.Get("/:id", handler.GetTransactionHandler(client))
+	transactionGroup.Post("/:id/refund", handler.RefundTransactionHandler(client, broker))
+	transactionGroup.Get("/:id/order", handler.GetOrderStatusHandler(client))
+	transactionGroup.Patch("/:id/fulfillment", handler.UpdateFulfillmentHandler(client, broker))
 }
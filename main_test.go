@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"regexp"
+	"somapay-backend/config"
+	"somapay-backend/ent"
+	"somapay-backend/events"
+	"somapay-backend/middleware"
+	"somapay-backend/openapi"
+	"somapay-backend/storage"
+	"strings"
+	"testing"
+	"time"
+)
+
+var routeParam = regexp.MustCompile(`:([A-Za-z_]+)`)
+
+// registeredRoutes 는 setupRoutes 가 등록한 라우트를 "METHOD /path/{param}" 형태로 돌려준다.
+// 라우트를 등록만 하고 요청은 보내지 않으므로 DB 연결은 필요 없다.
+func registeredRoutes(t *testing.T) map[string]bool {
+	t.Helper()
+
+	app := fiber.New()
+	setupRoutes(app, ent.NewClient(), storage.GetSessionStore(0, 0), storage.NewSheetStore(time.Hour),
+		events.NewBroker(1), middleware.NewDrain(), config.Default(), context.Background())
+
+	routes := map[string]bool{}
+	for _, r := range app.GetRoutes(true) {
+		// GET 을 등록하면 fiber 가 HEAD 를 같이 등록한다
+		if r.Method == fiber.MethodHead {
+			continue
+		}
+		path := r.Path
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		routes[r.Method+" "+routeParam.ReplaceAllString(path, "{$1}")] = true
+	}
+	return routes
+}
+
+func specRoutes(t *testing.T) map[string]bool {
+	t.Helper()
+
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openapi.JSON(), &doc); err != nil {
+		t.Fatalf("openapi.json 을 읽을 수 없음: %v", err)
+	}
+
+	routes := map[string]bool{}
+	for path, methods := range doc.Paths {
+		for method := range methods {
+			routes[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+	return routes
+}
+
+func TestOpenAPICoversRoutes(t *testing.T) {
+	registered := registeredRoutes(t)
+	documented := specRoutes(t)
+
+	for r := range registered {
+		if !documented[r] {
+			t.Errorf("%s 가 openapi/paths.go 에 없음", r)
+		}
+	}
+	for r := range documented {
+		if !registered[r] {
+			t.Errorf("%s 가 명세에는 있지만 setupRoutes 에 없음", r)
+		}
+	}
+}
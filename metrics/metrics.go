@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"database/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Registry 는 /metrics 로 내보내는 지표 모음이다.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "somapay_http_requests_total",
+		Help: "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "somapay_http_request_duration_seconds",
+		Help:    "HTTP request latency by method and route.",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"method", "route"})
+
+	payments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "somapay_payments_total",
+		Help: "Payment attempts by result (success, failure) and failure reason.",
+	}, []string{"result", "reason"})
+
+	chargeDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "somapay_charge_request_decisions_total",
+		Help: "Committed charge request decisions by status and how they were made (manual, batch, auto).",
+	}, []string{"status", "mode"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		payments,
+		chargeDecisions,
+	)
+}
+
+// Handler 는 Registry 를 Prometheus 텍스트 형식으로 내보낸다.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDB 는 커넥션 풀 상태(열린 연결, 대기 횟수 등)를 내보낸다.
+func RegisterDB(db *sql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterActiveSessions 는 count 가 돌려주는 로그인 세션 수를 내보낸다.
+func RegisterActiveSessions(count func() int) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "somapay_active_sessions",
+		Help: "Logged-in sessions that have not expired.",
+	}, func() float64 {
+		return float64(count())
+	}))
+}
+
+// ObserveRequest 는 route 가 라우트 패턴(/users/:id)이어야 한다. 실제 경로를 쓰면 라벨이 끝없이 늘어난다.
+func ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// RecordPayment 는 결제 시도 하나를 센다. reason 이 비어 있으면 성공이다.
+func RecordPayment(reason string) {
+	if reason == "" {
+		payments.WithLabelValues("success", "").Inc()
+		return
+	}
+	payments.WithLabelValues("failure", reason).Inc()
+}
+
+// RecordChargeDecision 은 커밋된 충전 요청 결정을 센다.
+func RecordChargeDecision(status, mode string, n int) {
+	chargeDecisions.WithLabelValues(status, mode).Add(float64(n))
+}
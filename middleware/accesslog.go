@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"log/slog"
+	"somapay-backend/ent"
+	"time"
+)
+
+// 상태 확인과 지표 수집 요청은 자주 오므로 debug 로만 남긴다
+var quietPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// AccessLog 는 요청마다 메서드, 라우트, 상태 코드, 처리 시간, 유저를 한 줄로 남긴다.
+// 유저는 AuthMiddleware 가 c.Locals("user") 에 넣은 값을 요청이 끝난 뒤 읽는다.
+func AccessLog(logger *slog.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		if err := c.Next(); err != nil {
+			if err := c.App().ErrorHandler(c, err); err != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		attrs := []slog.Attr{
+			slog.String("request_id", GetRequestID(c)),
+			slog.String("method", c.Method()),
+			slog.String("route", routePattern(c)),
+			slog.String("path", c.Path()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("ip", c.IP()),
+		}
+		if u, ok := c.Locals("user").(*ent.User); ok {
+			attrs = append(attrs, slog.Int("user_id", u.ID), slog.String("role", u.Role))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			level = slog.LevelError
+		case quietPaths[c.Path()]:
+			level = slog.LevelDebug
+		}
+		logger.LogAttrs(c.Context(), level, "request", attrs...)
+		return nil
+	}
+}
+
+// routePattern 은 요청이 끝난 뒤 맞은 라우트 패턴(/users/:id)을 돌려준다.
+// 맞는 라우트가 없으면 마지막으로 지나간 미들웨어의 경로(/, /users 등)가 된다.
+func routePattern(c *fiber.Ctx) string {
+	return c.Route().Path
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
 	"somapay-backend/ent"
 	"somapay-backend/storage"
 )
@@ -10,23 +11,28 @@ func AuthMiddleware(client *ent.Client, sessionStore *storage.SessionStore) fibe
 	return func(c *fiber.Ctx) error {
 		token := c.Get("Authorization")
 		if token == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "missing token"})
+			return apierror.MissingToken
 		}
 
-		sessionStore.RLock()
-		userID, ok := sessionStore.Data[token]
-		sessionStore.RUnlock()
-
+		sess, ok := sessionStore.Lookup(token)
 		if !ok {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid token"})
+			return apierror.InvalidToken
 		}
 
-		u, err := client.User.Get(c.Context(), userID)
+		u, err := client.User.Get(c.Context(), sess.UserID)
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid user"})
+			return apierror.InvalidToken
+		}
+
+		// 관리 도구로 세션을 끊은 뒤 발급된 세션만 인정한다
+		if u.SessionsRevokedAt != nil && !sess.CreatedAt.After(*u.SessionsRevokedAt) {
+			sessionStore.Remove(token)
+			return apierror.SessionRevoked
 		}
 
 		c.Locals("user", u)
+		// 감사 로그가 요청 컨텍스트에서 읽는다
+		c.Locals("ip", c.IP())
 		return c.Next()
 	}
 }
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"somapay-backend/apierror"
+	"sync"
+)
+
+// Drain 은 종료할 때 처리 중인 요청이 끝나기를 기다린다.
+// fasthttp 는 Shutdown 을 부르는 순간 요청 컨텍스트(c.Context())를 취소하므로,
+// 결제처럼 트랜잭션 중간인 요청이 취소되지 않도록 Shutdown 전에 먼저 비운다.
+type Drain struct {
+	sync.RWMutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+func NewDrain() *Drain {
+	return &Drain{}
+}
+
+// Handler 는 처리 중인 요청 수를 세고, 종료 중에 들어온 요청은 503 으로 돌려보낸다.
+func (d *Drain) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		d.RLock()
+		if d.draining {
+			d.RUnlock()
+			c.Context().SetConnectionClose()
+			return apierror.ShuttingDown
+		}
+		d.wg.Add(1)
+		d.RUnlock()
+		defer d.wg.Done()
+
+		err := c.Next()
+
+		// 종료 중이면 keep-alive 연결을 끊어 클라이언트가 다른 서버로 다시 연결하게 한다
+		if d.Draining() {
+			c.Context().SetConnectionClose()
+		}
+		return err
+	}
+}
+
+// Track 은 핸들러가 돌아온 뒤에도 본문을 쓰는 스트림(내보내기, 이벤트 스트림)을 처리 중인 요청으로 센다.
+// Handler 를 거친 요청 안에서 불러야 하고, 스트림이 끝나면 돌려받은 함수를 부른다.
+func (d *Drain) Track() (done func()) {
+	d.wg.Add(1)
+	return d.wg.Done
+}
+
+func (d *Drain) Draining() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.draining
+}
+
+// Wait 는 새 요청을 막고 처리 중인 요청이 모두 끝나거나 ctx 가 끝날 때까지 기다린다.
+func (d *Drain) Wait(ctx context.Context) error {
+	d.Lock()
+	d.draining = true
+	d.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
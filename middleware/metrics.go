@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"net/netip"
+	"somapay-backend/metrics"
+	"strings"
+	"time"
+)
+
+// Metrics 는 라우트별 요청 수와 처리 시간을 기록한다.
+func Metrics() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		if err := c.Next(); err != nil {
+			if err := c.App().ErrorHandler(c, err); err != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		// c.Method() 는 요청 버퍼를 가리키므로 라벨로 남기기 전에 복사한다
+		metrics.ObserveRequest(strings.Clone(c.Method()), routePattern(c), c.Response().StatusCode(), time.Since(start))
+		return nil
+	}
+}
+
+// TrustedNetwork 는 networks 안에서 온 요청을 로그인 없이 통과시키고, 나머지는 auth 로 넘긴다.
+// 통과한 요청에는 c.Locals("trusted_network") 가 true 다.
+// 프록시 헤더는 믿지 않고 연결한 주소로만 판단한다.
+func TrustedNetwork(networks []netip.Prefix, auth fiber.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ip, err := netip.ParseAddr(c.IP())
+		if err == nil {
+			ip = ip.Unmap()
+			for _, n := range networks {
+				if n.Contains(ip) {
+					c.Locals("trusted_network", true)
+					return c.Next()
+				}
+			}
+		}
+		return auth(c)
+	}
+}
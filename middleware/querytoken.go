@@ -0,0 +1,16 @@
+package middleware
+
+import "github.com/gofiber/fiber/v2"
+
+// QueryTokenMiddleware 는 헤더를 지정할 수 없는 EventSource 를 위해
+// token 쿼리 파라미터를 Authorization 헤더로 옮긴다.
+func QueryTokenMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Get("Authorization") == "" {
+			if token := c.Query("token"); token != "" {
+				c.Request().Header.Set("Authorization", token)
+			}
+		}
+		return c.Next()
+	}
+}
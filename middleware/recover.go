@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"log/slog"
+	"runtime/debug"
+	"somapay-backend/apierror"
+)
+
+// Recover 는 핸들러 패닉을 잡아 스택과 함께 로그에 남기고 INTERNAL 에러(500)로 응답한다.
+func Recover(logger *slog.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("panic recovered",
+					"request_id", GetRequestID(c),
+					"method", c.Method(),
+					"path", c.Path(),
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+				err = apierror.Internal.Wrap(fmt.Errorf("panic: %v", r))
+			}
+		}()
+
+		return c.Next()
+	}
+}
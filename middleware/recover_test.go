@@ -0,0 +1,34 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"somapay-backend/handler"
+	"somapay-backend/middleware"
+	"testing"
+)
+
+func TestRecoverReturnsInternalError(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: handler.ErrorHandler})
+	app.Use(middleware.Recover(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	app.Get("/", func(c *fiber.Ctx) error {
+		panic("boom")
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var body map[string]any
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != fiber.StatusInternalServerError || body["code"] != "INTERNAL" {
+		t.Errorf("status = %d, body = %v", res.StatusCode, body)
+	}
+}
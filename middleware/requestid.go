@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const maxRequestIDLength = 128
+
+// RequestID 는 X-Request-ID 헤더를 이어받거나 새로 만들어 응답 헤더와 c.Locals("request_id") 에 둔다.
+// 에러 응답(JSON 객체)에는 request_id 필드로도 넣어 문의할 때 로그를 찾을 수 있게 한다.
+func RequestID() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(fiber.HeaderXRequestID)
+		if !validRequestID(id) {
+			id = uuid.New().String()
+		}
+		c.Locals("request_id", id)
+		c.Set(fiber.HeaderXRequestID, id)
+
+		// 돌려받은 에러는 여기서 응답으로 바꿔야 아래에서 본문에 ID 를 넣을 수 있다
+		if err := c.Next(); err != nil {
+			if err := c.App().ErrorHandler(c, err); err != nil {
+				return err
+			}
+		}
+
+		if c.Response().StatusCode() >= fiber.StatusBadRequest {
+			addRequestID(c, id)
+		}
+		return nil
+	}
+}
+
+func addRequestID(c *fiber.Ctx, id string) {
+	if !bytes.HasPrefix(c.Response().Header.ContentType(), []byte(fiber.MIMEApplicationJSON)) {
+		return
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(c.Response().Body(), &body); err != nil {
+		return
+	}
+	body["request_id"], _ = json.Marshal(id)
+	_ = c.JSON(body)
+}
+
+// validRequestID 는 로그에 그대로 남겨도 되는 ID 만 받는다.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// GetRequestID 는 RequestID 가 정한 요청 ID 를 돌려준다.
+func GetRequestID(c *fiber.Ctx) string {
+	id, _ := c.Locals("request_id").(string)
+	return id
+}
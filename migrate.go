@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"somapay-backend/cli"
+	"somapay-backend/config"
+	"somapay-backend/migrations"
+	"somapay-backend/storage"
+	"strconv"
+)
+
+const migrateUsage = `usage: somapay migrate <command>
+
+commands:
+  status                     적용된 버전과 마이그레이션 목록
+  up [-dry-run]              남은 마이그레이션을 모두 적용
+  down <version> [-dry-run]  version 보다 새 마이그레이션을 되돌림 (0 이면 전부)
+  force <version>            SQL 실행 없이 버전만 기록
+  diff <name> [-dev-dsn dsn] [-dir dir]
+                             ent 스키마와의 차이를 새 마이그레이션 파일로 생성`
+
+func runMigrate(ctx context.Context, cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	cmd, args := args[0], args[1:]
+
+	if cmd == "diff" {
+		return migrateDiff(ctx, cfg, args)
+	}
+
+	fs := flag.NewFlagSet("migrate "+cmd, flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "print the SQL without running it")
+	if err := cli.ParseArgs(fs, args); err != nil {
+		return err
+	}
+
+	db, _, err := storage.OpenDB(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	m, err := migrations.New(db, cfg.Database.Driver)
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case "status":
+		return migrateStatus(ctx, m)
+
+	case "up":
+		steps, err := m.UpSteps(ctx)
+		if err != nil {
+			return err
+		}
+		return runSteps(ctx, m, steps, *dryRun)
+
+	case "down":
+		target, err := versionArg(fs)
+		if err != nil {
+			return err
+		}
+		steps, err := m.DownSteps(ctx, target)
+		if err != nil {
+			return err
+		}
+		return runSteps(ctx, m, steps, *dryRun)
+
+	case "force":
+		version, err := versionArg(fs)
+		if err != nil {
+			return err
+		}
+		if err := m.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Printf("forced version %d\n", version)
+		return nil
+	}
+
+	return errors.New(migrateUsage)
+}
+
+func versionArg(fs *flag.FlagSet) (int64, error) {
+	if fs.NArg() != 1 {
+		return 0, errors.New("version is required")
+	}
+	v, err := strconv.ParseInt(fs.Arg(0), 10, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid version %q", fs.Arg(0))
+	}
+	return v, nil
+}
+
+func migrateStatus(ctx context.Context, m *migrations.Migrator) error {
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	state := ""
+	if dirty {
+		state = " (dirty)"
+	}
+	fmt.Printf("current version: %d%s\nlatest version:  %d\n\n", version, state, m.Latest())
+
+	for _, mig := range m.Migrations() {
+		status := "pending"
+		if mig.Version <= version {
+			status = "applied"
+		}
+		fmt.Printf("%-8s %d_%s\n", status, mig.Version, mig.Name)
+	}
+	return nil
+}
+
+func runSteps(ctx context.Context, m *migrations.Migrator, steps []migrations.Step, dryRun bool) error {
+	if len(steps) == 0 {
+		fmt.Println("no change")
+		return nil
+	}
+
+	if dryRun {
+		for _, s := range steps {
+			fmt.Printf("-- %s %d_%s\n%s\n", direction(s), s.Version, s.Name, s.SQL())
+		}
+		return nil
+	}
+
+	return m.Apply(ctx, steps, func(s migrations.Step) {
+		fmt.Printf("%s %d_%s\n", direction(s), s.Version, s.Name)
+	})
+}
+
+func direction(s migrations.Step) string {
+	if s.Down {
+		return "down"
+	}
+	return "up"
+}
+
+// migrateDiff 는 설정된 드라이버와 같은 종류의 빈 개발용 DB 에 기존 마이그레이션을 재생한 뒤
+// ent 스키마와 비교한다. sqlite 는 메모리 DB 를 쓰므로 dsn 을 주지 않아도 된다.
+func migrateDiff(ctx context.Context, cfg *config.Config, args []string) error {
+	fs := flag.NewFlagSet("migrate diff", flag.ContinueOnError)
+	devDSN := fs.String("dev-dsn", "", "DSN of an empty database used to replay migrations")
+	dir := fs.String("dir", "", "migration directory (default migrations/<driver>)")
+
+	if err := cli.ParseArgs(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("migration name is required")
+	}
+	name := fs.Arg(0)
+
+	driver := cfg.Database.Driver
+	if *devDSN == "" {
+		if driver != "sqlite" {
+			return errors.New("-dev-dsn is required for " + driver)
+		}
+		*devDSN = ":memory:"
+	}
+	if *dir == "" {
+		*dir = filepath.Join("migrations", driver)
+	}
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		return err
+	}
+
+	db, dialectName, err := storage.OpenDB(config.DatabaseConfig{Driver: driver, DSN: *devDSN})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = migrations.Diff(ctx, db, dialectName, *dir, name)
+	if errors.Is(err, migrations.ErrNoChanges) {
+		fmt.Println("no schema changes")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("wrote migration %q to %s\n", name, *dir)
+	return nil
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	atlas "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"context"
+	"database/sql"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"errors"
+	"somapay-backend/ent/migrate"
+)
+
+// ErrNoChanges 는 ent 스키마와 마이그레이션 파일 사이에 차이가 없을 때 Diff 가 돌려준다.
+var ErrNoChanges = errors.New("no schema changes")
+
+// Diff 는 dir 의 마이그레이션을 빈 개발용 DB(devDB)에 재생한 결과와 ent 스키마를 비교해
+// 차이를 name 이라는 새 마이그레이션 파일로 dir 에 쓴다.
+// devDB 는 운영 DB 와 같은 종류여야 하고, 비교가 끝나면 다시 비워진다.
+func Diff(ctx context.Context, devDB *sql.DB, dialectName, dir, name string) error {
+	// .down.sql 은 재생하지 않도록 golang-migrate 형식으로 읽는다
+	local, err := sqltool.NewGolangMigrateDir(dir)
+	if err != nil {
+		return err
+	}
+
+	m, err := schema.NewMigrate(
+		entsql.OpenDB(dialectName, devDB),
+		schema.WithDir(local),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithErrNoPlan(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = m.NamedDiff(ctx, name, migrate.Tables...)
+	if errors.Is(err, atlas.ErrNoPlan) {
+		return ErrNoChanges
+	}
+	return err
+}
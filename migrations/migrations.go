@@ -0,0 +1,341 @@
+package migrations
+
+import (
+	"ariga.io/atlas/sql/migrate"
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// 드라이버별 마이그레이션 파일. golang-migrate 형식(<version>_<name>.up.sql / .down.sql)이다.
+//
+//go:embed mysql/*.sql postgres/*.sql sqlite/*.sql
+var files embed.FS
+
+// 적용한 버전을 기록하는 테이블. golang-migrate 와 같은 모양으로 한 행만 둔다.
+const versionTable = "schema_migrations"
+
+// DDL 이 트랜잭션 안에서 실행되는 드라이버. 실패하면 전체가 되돌려진다.
+var transactionalDDL = map[string]bool{
+	"postgres": true,
+	"sqlite":   true,
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Step 은 실행할 마이그레이션 하나와 방향이다.
+type Step struct {
+	Migration
+	Down bool
+}
+
+func (s Step) SQL() string {
+	if s.Down {
+		return s.Migration.Down
+	}
+	return s.Migration.Up
+}
+
+type Migrator struct {
+	db         *sql.DB
+	driver     string
+	migrations []Migration
+}
+
+func New(db *sql.DB, driver string) (*Migrator, error) {
+	ms, err := Load(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, driver: driver, migrations: ms}, nil
+}
+
+// Load 는 driver 의 마이그레이션을 버전 순으로 읽는다.
+func Load(driver string) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, driver)
+	if err != nil {
+		return nil, fmt.Errorf("migrations: no migrations for driver %q", driver)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		m := fileName.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrations: invalid version in %s", e.Name())
+		}
+		b, err := files.ReadFile(path.Join(driver, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if m[3] == "up" {
+			mig.Up = string(b)
+		} else {
+			mig.Down = string(b)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Latest 는 가장 최근 마이그레이션 버전이다. 마이그레이션이 없으면 0.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// init 은 버전 테이블을 만든다. 버전을 기록하는 Apply 와 Force 에서만 부른다.
+func (m *Migrator) init(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+versionTable+" (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)")
+	return err
+}
+
+// tableExistsQuery 는 드라이버별로 versionTable 이 있는지 세는 쿼리다.
+var tableExistsQuery = map[string]string{
+	"mysql":    "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = '" + versionTable + "'",
+	"postgres": "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = '" + versionTable + "'",
+	"sqlite":   "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = '" + versionTable + "'",
+}
+
+// Version 은 DB 에 적용된 버전과 마지막 마이그레이션이 중간에 실패했는지(dirty)를 돌려준다.
+// 아무것도 적용하지 않았으면 0 이다. status, -dry-run, /readyz 에서 부르므로 DB 를 바꾸지 않고
+// 버전 테이블이 없으면 0 으로 본다.
+func (m *Migrator) Version(ctx context.Context) (int64, bool, error) {
+	var n int
+	if err := m.db.QueryRowContext(ctx, tableExistsQuery[m.driver]).Scan(&n); err != nil {
+		return 0, false, err
+	}
+	if n == 0 {
+		return 0, false, nil
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err := m.db.QueryRowContext(ctx, "SELECT version, dirty FROM "+versionTable).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Check 는 DB 가 이 빌드의 마이그레이션과 정확히 맞는지 확인한다.
+func (m *Migrator) Check(ctx context.Context) error {
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch latest := m.Latest(); {
+	case dirty:
+		return fmt.Errorf("migration %d failed halfway; fix the database and run `migrate force <version>`", version)
+	case version < latest:
+		return fmt.Errorf("database is at version %d but %d is required; run `migrate up`", version, latest)
+	case version > latest:
+		return fmt.Errorf("database is at version %d, newer than this build (%d)", version, latest)
+	}
+	return nil
+}
+
+// UpSteps 는 아직 적용하지 않은 마이그레이션을 적용할 순서대로 돌려준다.
+func (m *Migrator) UpSteps(ctx context.Context) ([]Step, error) {
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, fmt.Errorf("migration %d is dirty; run `migrate force <version>` first", version)
+	}
+
+	var steps []Step
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			steps = append(steps, Step{Migration: mig})
+		}
+	}
+	return steps, nil
+}
+
+// DownSteps 는 target 보다 새 버전을 되돌릴 순서대로 돌려준다. target 이 0 이면 전부 되돌린다.
+func (m *Migrator) DownSteps(ctx context.Context, target int64) ([]Step, error) {
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, fmt.Errorf("migration %d is dirty; run `migrate force <version>` first", version)
+	}
+	if target > version {
+		return nil, fmt.Errorf("target version %d is newer than the current version %d", target, version)
+	}
+	if target != 0 && m.index(target) < 0 {
+		return nil, fmt.Errorf("unknown version %d", target)
+	}
+
+	var steps []Step
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if mig.Version > target && mig.Version <= version {
+			steps = append(steps, Step{Migration: mig, Down: true})
+		}
+	}
+	return steps, nil
+}
+
+func (m *Migrator) index(version int64) int {
+	for i, mig := range m.migrations {
+		if mig.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// Apply 는 steps 를 차례로 실행한다.
+// 실행 전에 버전을 dirty 로 표시하고, 끝나면 표시를 지운다.
+// DDL 이 트랜잭션에 묶이는 드라이버는 실패하면 이전 버전으로 되돌아가고,
+// MySQL 은 dirty 로 남아 운영자가 확인한 뒤 force 로 정리해야 한다.
+func (m *Migrator) Apply(ctx context.Context, steps []Step, applied func(Step)) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	if err := m.init(ctx); err != nil {
+		return err
+	}
+
+	for _, s := range steps {
+		prev, _, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		target := s.Version
+		if s.Down {
+			target = m.previous(s.Version)
+		}
+
+		if err := m.setVersion(ctx, m.db, s.Version, true); err != nil {
+			return err
+		}
+		if err := m.run(ctx, s.SQL(), target); err != nil {
+			if transactionalDDL[m.driver] {
+				if rerr := m.setVersion(ctx, m.db, prev, false); rerr != nil {
+					err = errors.Join(err, rerr)
+				}
+			}
+			return fmt.Errorf("migration %d_%s: %w", s.Version, s.Name, err)
+		}
+		if applied != nil {
+			applied(s)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) run(ctx context.Context, script string, target int64) error {
+	stmts, err := Statements(script)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if err := m.setVersion(ctx, tx, target, false); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// previous 는 version 바로 앞의 마이그레이션 버전이다. 첫 번째면 0.
+func (m *Migrator) previous(version int64) int64 {
+	if i := m.index(version); i > 0 {
+		return m.migrations[i-1].Version
+	}
+	return 0
+}
+
+// Force 는 SQL 을 실행하지 않고 버전만 기록한다. 실패한 마이그레이션을 손으로 정리했거나
+// 이미 스키마가 있는 DB 를 처음 버전 관리에 올릴 때 쓴다.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("unknown version %d", version)
+	}
+	if err := m.init(ctx); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, m.db, version, false)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// setVersion 은 정수와 불리언 리터럴만 쓰므로 드라이버마다 다른 플레이스홀더를 피한다.
+func (m *Migrator) setVersion(ctx context.Context, db execer, version int64, dirty bool) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM "+versionTable); err != nil {
+		return err
+	}
+	if version == 0 && !dirty {
+		return nil
+	}
+	_, err := db.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (version, dirty) VALUES (%d, %t)", versionTable, version, dirty))
+	return err
+}
+
+// Statements 는 마이그레이션 파일을 문장 단위로 나눈다. MySQL 드라이버는 한 번에 한 문장만 실행한다.
+func Statements(script string) ([]string, error) {
+	stmts, err := migrate.Stmts(script)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(stmts))
+	for i, s := range stmts {
+		out[i] = s.Text
+	}
+	return out, nil
+}
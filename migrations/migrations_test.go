@@ -0,0 +1,79 @@
+package migrations_test
+
+import (
+	"path/filepath"
+	"somapay-backend/config"
+	"somapay-backend/migrations"
+	"somapay-backend/storage"
+	"testing"
+)
+
+func newTestMigrator(t *testing.T) (*migrations.Migrator, func() bool) {
+	t.Helper()
+
+	db, _, err := storage.OpenDB(config.DatabaseConfig{
+		Driver: "sqlite",
+		DSN:    filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	m, err := migrations.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tableExists := func() bool {
+		var n int
+		if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'schema_migrations'").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n > 0
+	}
+	return m, tableExists
+}
+
+func TestVersionDoesNotCreateTable(t *testing.T) {
+	m, tableExists := newTestMigrator(t)
+
+	version, dirty, err := m.Version(t.Context())
+	if err != nil || version != 0 || dirty {
+		t.Fatalf("Version = %d, %t, %v; want 0, false, nil", version, dirty, err)
+	}
+	if err := m.Check(t.Context()); err == nil {
+		t.Error("Check on an empty database succeeded")
+	}
+	if _, err := m.UpSteps(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if tableExists() {
+		t.Error("schema_migrations was created by a read-only call")
+	}
+}
+
+func TestApplyRecordsVersion(t *testing.T) {
+	m, tableExists := newTestMigrator(t)
+
+	steps, err := m.UpSteps(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Apply(t.Context(), steps, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !tableExists() {
+		t.Fatal("schema_migrations was not created")
+	}
+	if err := m.Check(t.Context()); err != nil {
+		t.Errorf("Check after migrate up: %v", err)
+	}
+
+	if err := m.Force(t.Context(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if version, _, _ := m.Version(t.Context()); version != 0 {
+		t.Errorf("version after force 0 = %d", version)
+	}
+}
@@ -0,0 +1,22 @@
+-- reverse: create "webhook_deliveries" table
+DROP TABLE `webhook_deliveries`;
+-- reverse: create "webhooks" table
+DROP TABLE `webhooks`;
+-- reverse: create "outbox_events" table
+DROP TABLE `outbox_events`;
+-- reverse: create "transactions" table
+DROP TABLE `transactions`;
+-- reverse: create "products" table
+DROP TABLE `products`;
+-- reverse: create "charge_requests" table
+DROP TABLE `charge_requests`;
+-- reverse: create "auto_approval_rules" table
+DROP TABLE `auto_approval_rules`;
+-- reverse: create "booths" table
+DROP TABLE `booths`;
+-- reverse: create "adjustments" table
+DROP TABLE `adjustments`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "audit_logs" table
+DROP TABLE `audit_logs`;
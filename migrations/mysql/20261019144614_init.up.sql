@@ -0,0 +1,22 @@
+-- create "audit_logs" table
+CREATE TABLE `audit_logs` (`id` bigint NOT NULL AUTO_INCREMENT, `entity_type` varchar(255) NOT NULL, `entity_id` bigint NOT NULL, `action` varchar(255) NOT NULL, `actor_id` bigint NULL, `actor_username` varchar(255) NULL, `ip` varchar(255) NULL, `changes` json NOT NULL, `timestamp` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `auditlog_entity_type_entity_id` (`entity_type`, `entity_id`), INDEX `auditlog_actor_id` (`actor_id`), INDEX `auditlog_timestamp` (`timestamp`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "users" table
+CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(255) NOT NULL, `password` varchar(255) NOT NULL, `point` bigint NOT NULL, `pin` varchar(255) NOT NULL, `role` varchar(255) NOT NULL DEFAULT 'USER', PRIMARY KEY (`id`), UNIQUE INDEX `username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "adjustments" table
+CREATE TABLE `adjustments` (`id` bigint NOT NULL AUTO_INCREMENT, `amount` bigint NOT NULL, `reason` varchar(255) NOT NULL, `note` varchar(255) NULL, `timestamp` timestamp NOT NULL, `user_adjustments` bigint NOT NULL, `user_issued_adjustments` bigint NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `adjustments_users_adjustments` FOREIGN KEY (`user_adjustments`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `adjustments_users_issued_adjustments` FOREIGN KEY (`user_issued_adjustments`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "booths" table
+CREATE TABLE `booths` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `order_seq` bigint NOT NULL DEFAULT 0, `user_booth` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `name` (`name`), UNIQUE INDEX `user_booth` (`user_booth`), CONSTRAINT `booths_users_booth` FOREIGN KEY (`user_booth`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "auto_approval_rules" table
+CREATE TABLE `auto_approval_rules` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `max_amount` bigint NULL, `role` varchar(255) NULL, `max_daily_approvals` bigint NULL, `enabled` bool NOT NULL DEFAULT true, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "charge_requests" table
+CREATE TABLE `charge_requests` (`id` bigint NOT NULL AUTO_INCREMENT, `amount` bigint NOT NULL, `status` varchar(255) NOT NULL DEFAULT 'PENDING', `depositor_name` varchar(255) NULL, `payment_method` varchar(255) NULL, `created_at` timestamp NOT NULL, `decided_at` timestamp NULL, `auto_approval_rule_charge_requests` bigint NULL, `charge_request_user` bigint NOT NULL, `user_charge_requests` bigint NULL, PRIMARY KEY (`id`), INDEX `chargerequest_status` (`status`), INDEX `chargerequest_created_at` (`created_at`), CONSTRAINT `charge_requests_auto_approval_rules_charge_requests` FOREIGN KEY (`auto_approval_rule_charge_requests`) REFERENCES `auto_approval_rules` (`id`) ON DELETE SET NULL, CONSTRAINT `charge_requests_users_user` FOREIGN KEY (`charge_request_user`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `charge_requests_users_charge_requests` FOREIGN KEY (`user_charge_requests`) REFERENCES `users` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "products" table
+CREATE TABLE `products` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `description` varchar(255) NULL, `price` bigint NOT NULL, `booth_products` bigint NULL, `product_booth` bigint NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `products_booths_products` FOREIGN KEY (`booth_products`) REFERENCES `booths` (`id`) ON DELETE SET NULL, CONSTRAINT `products_booths_booth` FOREIGN KEY (`product_booth`) REFERENCES `booths` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "transactions" table
+CREATE TABLE `transactions` (`id` bigint NOT NULL AUTO_INCREMENT, `quantity` bigint NOT NULL, `amount` bigint NOT NULL, `status` varchar(255) NOT NULL, `timestamp` timestamp NOT NULL, `refunded_at` timestamp NULL, `order_number` bigint NOT NULL DEFAULT 0, `fulfillment_status` varchar(255) NOT NULL DEFAULT 'RECEIVED', `booth_transactions` bigint NOT NULL, `product_transactions` bigint NOT NULL, `user_transactions` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `transaction_timestamp` (`timestamp`), INDEX `transaction_booth_transactions_timestamp` (`booth_transactions`, `timestamp`), INDEX `transaction_booth_transactions_fulfillment_status` (`booth_transactions`, `fulfillment_status`), CONSTRAINT `transactions_booths_transactions` FOREIGN KEY (`booth_transactions`) REFERENCES `booths` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_products_transactions` FOREIGN KEY (`product_transactions`) REFERENCES `products` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_users_transactions` FOREIGN KEY (`user_transactions`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "outbox_events" table
+CREATE TABLE `outbox_events` (`id` bigint NOT NULL AUTO_INCREMENT, `type` varchar(255) NOT NULL, `payload` longtext NOT NULL, `dispatched` bool NOT NULL DEFAULT false, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `outboxevent_dispatched` (`dispatched`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "webhooks" table
+CREATE TABLE `webhooks` (`id` bigint NOT NULL AUTO_INCREMENT, `url` varchar(255) NOT NULL, `secret` varchar(255) NOT NULL, `events` json NULL, `enabled` bool NOT NULL DEFAULT true, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "webhook_deliveries" table
+CREATE TABLE `webhook_deliveries` (`id` bigint NOT NULL AUTO_INCREMENT, `status` varchar(255) NOT NULL DEFAULT 'PENDING', `attempts` bigint NOT NULL DEFAULT 0, `next_attempt_at` timestamp NOT NULL, `last_status_code` bigint NULL, `last_error` varchar(255) NULL, `delivered_at` timestamp NULL, `created_at` timestamp NOT NULL, `outbox_event_deliveries` bigint NOT NULL, `webhook_deliveries` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `webhookdelivery_status_next_attempt_at` (`status`, `next_attempt_at`), CONSTRAINT `webhook_deliveries_outbox_events_deliveries` FOREIGN KEY (`outbox_event_deliveries`) REFERENCES `outbox_events` (`id`) ON DELETE NO ACTION, CONSTRAINT `webhook_deliveries_webhooks_deliveries` FOREIGN KEY (`webhook_deliveries`) REFERENCES `webhooks` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- modify "users" table
+ALTER TABLE `users` ADD COLUMN `name` varchar(255) NULL, ADD COLUMN `grade` bigint NULL, ADD COLUMN `class` bigint NULL;
@@ -0,0 +1,48 @@
+-- reverse: create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_status_next_attempt_at";
+-- reverse: create "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- reverse: create "webhooks" table
+DROP TABLE "webhooks";
+-- reverse: create index "outboxevent_dispatched" to table: "outbox_events"
+DROP INDEX "outboxevent_dispatched";
+-- reverse: create "outbox_events" table
+DROP TABLE "outbox_events";
+-- reverse: create index "transaction_booth_transactions_fulfillment_status" to table: "transactions"
+DROP INDEX "transaction_booth_transactions_fulfillment_status";
+-- reverse: create index "transaction_booth_transactions_timestamp" to table: "transactions"
+DROP INDEX "transaction_booth_transactions_timestamp";
+-- reverse: create index "transaction_timestamp" to table: "transactions"
+DROP INDEX "transaction_timestamp";
+-- reverse: create "transactions" table
+DROP TABLE "transactions";
+-- reverse: create "products" table
+DROP TABLE "products";
+-- reverse: create index "chargerequest_created_at" to table: "charge_requests"
+DROP INDEX "chargerequest_created_at";
+-- reverse: create index "chargerequest_status" to table: "charge_requests"
+DROP INDEX "chargerequest_status";
+-- reverse: create "charge_requests" table
+DROP TABLE "charge_requests";
+-- reverse: create "auto_approval_rules" table
+DROP TABLE "auto_approval_rules";
+-- reverse: create index "booths_user_booth_key" to table: "booths"
+DROP INDEX "booths_user_booth_key";
+-- reverse: create index "booths_name_key" to table: "booths"
+DROP INDEX "booths_name_key";
+-- reverse: create "booths" table
+DROP TABLE "booths";
+-- reverse: create "adjustments" table
+DROP TABLE "adjustments";
+-- reverse: create index "users_username_key" to table: "users"
+DROP INDEX "users_username_key";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create index "auditlog_timestamp" to table: "audit_logs"
+DROP INDEX "auditlog_timestamp";
+-- reverse: create index "auditlog_actor_id" to table: "audit_logs"
+DROP INDEX "auditlog_actor_id";
+-- reverse: create index "auditlog_entity_type_entity_id" to table: "audit_logs"
+DROP INDEX "auditlog_entity_type_entity_id";
+-- reverse: create "audit_logs" table
+DROP TABLE "audit_logs";
@@ -0,0 +1,48 @@
+-- create "audit_logs" table
+CREATE TABLE "audit_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "action" character varying NOT NULL, "actor_id" bigint NULL, "actor_username" character varying NULL, "ip" character varying NULL, "changes" jsonb NOT NULL, "timestamp" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditlog_entity_type_entity_id" to table: "audit_logs"
+CREATE INDEX "auditlog_entity_type_entity_id" ON "audit_logs" ("entity_type", "entity_id");
+-- create index "auditlog_actor_id" to table: "audit_logs"
+CREATE INDEX "auditlog_actor_id" ON "audit_logs" ("actor_id");
+-- create index "auditlog_timestamp" to table: "audit_logs"
+CREATE INDEX "auditlog_timestamp" ON "audit_logs" ("timestamp");
+-- create "users" table
+CREATE TABLE "users" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "username" character varying NOT NULL, "password" character varying NOT NULL, "point" bigint NOT NULL, "pin" character varying NOT NULL, "role" character varying NOT NULL DEFAULT 'USER', PRIMARY KEY ("id"));
+-- create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX "users_username_key" ON "users" ("username");
+-- create "adjustments" table
+CREATE TABLE "adjustments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "amount" bigint NOT NULL, "reason" character varying NOT NULL, "note" character varying NULL, "timestamp" timestamptz NOT NULL, "user_adjustments" bigint NOT NULL, "user_issued_adjustments" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "adjustments_users_adjustments" FOREIGN KEY ("user_adjustments") REFERENCES "users" ("id") ON DELETE NO ACTION, CONSTRAINT "adjustments_users_issued_adjustments" FOREIGN KEY ("user_issued_adjustments") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create "booths" table
+CREATE TABLE "booths" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "order_seq" bigint NOT NULL DEFAULT 0, "user_booth" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "booths_users_booth" FOREIGN KEY ("user_booth") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "booths_name_key" to table: "booths"
+CREATE UNIQUE INDEX "booths_name_key" ON "booths" ("name");
+-- create index "booths_user_booth_key" to table: "booths"
+CREATE UNIQUE INDEX "booths_user_booth_key" ON "booths" ("user_booth");
+-- create "auto_approval_rules" table
+CREATE TABLE "auto_approval_rules" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "max_amount" bigint NULL, "role" character varying NULL, "max_daily_approvals" bigint NULL, "enabled" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "charge_requests" table
+CREATE TABLE "charge_requests" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "amount" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'PENDING', "depositor_name" character varying NULL, "payment_method" character varying NULL, "created_at" timestamptz NOT NULL, "decided_at" timestamptz NULL, "auto_approval_rule_charge_requests" bigint NULL, "charge_request_user" bigint NOT NULL, "user_charge_requests" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "charge_requests_auto_approval_rules_charge_requests" FOREIGN KEY ("auto_approval_rule_charge_requests") REFERENCES "auto_approval_rules" ("id") ON DELETE SET NULL, CONSTRAINT "charge_requests_users_user" FOREIGN KEY ("charge_request_user") REFERENCES "users" ("id") ON DELETE NO ACTION, CONSTRAINT "charge_requests_users_charge_requests" FOREIGN KEY ("user_charge_requests") REFERENCES "users" ("id") ON DELETE SET NULL);
+-- create index "chargerequest_status" to table: "charge_requests"
+CREATE INDEX "chargerequest_status" ON "charge_requests" ("status");
+-- create index "chargerequest_created_at" to table: "charge_requests"
+CREATE INDEX "chargerequest_created_at" ON "charge_requests" ("created_at");
+-- create "products" table
+CREATE TABLE "products" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "description" character varying NULL, "price" bigint NOT NULL, "booth_products" bigint NULL, "product_booth" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "products_booths_products" FOREIGN KEY ("booth_products") REFERENCES "booths" ("id") ON DELETE SET NULL, CONSTRAINT "products_booths_booth" FOREIGN KEY ("product_booth") REFERENCES "booths" ("id") ON DELETE NO ACTION);
+-- create "transactions" table
+CREATE TABLE "transactions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "quantity" bigint NOT NULL, "amount" bigint NOT NULL, "status" character varying NOT NULL, "timestamp" timestamptz NOT NULL, "refunded_at" timestamptz NULL, "order_number" bigint NOT NULL DEFAULT 0, "fulfillment_status" character varying NOT NULL DEFAULT 'RECEIVED', "booth_transactions" bigint NOT NULL, "product_transactions" bigint NOT NULL, "user_transactions" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "transactions_booths_transactions" FOREIGN KEY ("booth_transactions") REFERENCES "booths" ("id") ON DELETE NO ACTION, CONSTRAINT "transactions_products_transactions" FOREIGN KEY ("product_transactions") REFERENCES "products" ("id") ON DELETE NO ACTION, CONSTRAINT "transactions_users_transactions" FOREIGN KEY ("user_transactions") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "transaction_timestamp" to table: "transactions"
+CREATE INDEX "transaction_timestamp" ON "transactions" ("timestamp");
+-- create index "transaction_booth_transactions_timestamp" to table: "transactions"
+CREATE INDEX "transaction_booth_transactions_timestamp" ON "transactions" ("booth_transactions", "timestamp");
+-- create index "transaction_booth_transactions_fulfillment_status" to table: "transactions"
+CREATE INDEX "transaction_booth_transactions_fulfillment_status" ON "transactions" ("booth_transactions", "fulfillment_status");
+-- create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "type" character varying NOT NULL, "payload" text NOT NULL, "dispatched" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "outboxevent_dispatched" to table: "outbox_events"
+CREATE INDEX "outboxevent_dispatched" ON "outbox_events" ("dispatched");
+-- create "webhooks" table
+CREATE TABLE "webhooks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "url" character varying NOT NULL, "secret" character varying NOT NULL, "events" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "status" character varying NOT NULL DEFAULT 'PENDING', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "last_status_code" bigint NULL, "last_error" character varying NULL, "delivered_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "outbox_event_deliveries" bigint NOT NULL, "webhook_deliveries" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_outbox_events_deliveries" FOREIGN KEY ("outbox_event_deliveries") REFERENCES "outbox_events" ("id") ON DELETE NO ACTION, CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_deliveries") REFERENCES "webhooks" ("id") ON DELETE NO ACTION);
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,2 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "name" character varying NULL, ADD COLUMN "grade" bigint NULL, ADD COLUMN "class" bigint NULL;
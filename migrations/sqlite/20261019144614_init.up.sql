@@ -0,0 +1,48 @@
+-- create "adjustments" table
+CREATE TABLE `adjustments` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` integer NOT NULL, `reason` text NOT NULL, `note` text NULL, `timestamp` datetime NOT NULL, `user_adjustments` integer NOT NULL, `user_issued_adjustments` integer NOT NULL, CONSTRAINT `adjustments_users_adjustments` FOREIGN KEY (`user_adjustments`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `adjustments_users_issued_adjustments` FOREIGN KEY (`user_issued_adjustments`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create "audit_logs" table
+CREATE TABLE `audit_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `entity_type` text NOT NULL, `entity_id` integer NOT NULL, `action` text NOT NULL, `actor_id` integer NULL, `actor_username` text NULL, `ip` text NULL, `changes` json NOT NULL, `timestamp` datetime NOT NULL);
+-- create index "auditlog_entity_type_entity_id" to table: "audit_logs"
+CREATE INDEX `auditlog_entity_type_entity_id` ON `audit_logs` (`entity_type`, `entity_id`);
+-- create index "auditlog_actor_id" to table: "audit_logs"
+CREATE INDEX `auditlog_actor_id` ON `audit_logs` (`actor_id`);
+-- create index "auditlog_timestamp" to table: "audit_logs"
+CREATE INDEX `auditlog_timestamp` ON `audit_logs` (`timestamp`);
+-- create "auto_approval_rules" table
+CREATE TABLE `auto_approval_rules` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `max_amount` integer NULL, `role` text NULL, `max_daily_approvals` integer NULL, `enabled` bool NOT NULL DEFAULT (true), `created_at` datetime NOT NULL);
+-- create "booths" table
+CREATE TABLE `booths` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `order_seq` integer NOT NULL DEFAULT (0), `user_booth` integer NOT NULL, CONSTRAINT `booths_users_booth` FOREIGN KEY (`user_booth`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "booths_name_key" to table: "booths"
+CREATE UNIQUE INDEX `booths_name_key` ON `booths` (`name`);
+-- create index "booths_user_booth_key" to table: "booths"
+CREATE UNIQUE INDEX `booths_user_booth_key` ON `booths` (`user_booth`);
+-- create "charge_requests" table
+CREATE TABLE `charge_requests` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` integer NOT NULL, `status` text NOT NULL DEFAULT ('PENDING'), `depositor_name` text NULL, `payment_method` text NULL, `created_at` datetime NOT NULL, `decided_at` datetime NULL, `auto_approval_rule_charge_requests` integer NULL, `charge_request_user` integer NOT NULL, `user_charge_requests` integer NULL, CONSTRAINT `charge_requests_auto_approval_rules_charge_requests` FOREIGN KEY (`auto_approval_rule_charge_requests`) REFERENCES `auto_approval_rules` (`id`) ON DELETE SET NULL, CONSTRAINT `charge_requests_users_user` FOREIGN KEY (`charge_request_user`) REFERENCES `users` (`id`) ON DELETE NO ACTION, CONSTRAINT `charge_requests_users_charge_requests` FOREIGN KEY (`user_charge_requests`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- create index "chargerequest_status" to table: "charge_requests"
+CREATE INDEX `chargerequest_status` ON `charge_requests` (`status`);
+-- create index "chargerequest_created_at" to table: "charge_requests"
+CREATE INDEX `chargerequest_created_at` ON `charge_requests` (`created_at`);
+-- create "outbox_events" table
+CREATE TABLE `outbox_events` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `type` text NOT NULL, `payload` text NOT NULL, `dispatched` bool NOT NULL DEFAULT (false), `created_at` datetime NOT NULL);
+-- create index "outboxevent_dispatched" to table: "outbox_events"
+CREATE INDEX `outboxevent_dispatched` ON `outbox_events` (`dispatched`);
+-- create "products" table
+CREATE TABLE `products` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL, `price` integer NOT NULL, `booth_products` integer NULL, `product_booth` integer NOT NULL, CONSTRAINT `products_booths_products` FOREIGN KEY (`booth_products`) REFERENCES `booths` (`id`) ON DELETE SET NULL, CONSTRAINT `products_booths_booth` FOREIGN KEY (`product_booth`) REFERENCES `booths` (`id`) ON DELETE NO ACTION);
+-- create "transactions" table
+CREATE TABLE `transactions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `quantity` integer NOT NULL, `amount` integer NOT NULL, `status` text NOT NULL, `timestamp` datetime NOT NULL, `refunded_at` datetime NULL, `order_number` integer NOT NULL DEFAULT (0), `fulfillment_status` text NOT NULL DEFAULT ('RECEIVED'), `booth_transactions` integer NOT NULL, `product_transactions` integer NOT NULL, `user_transactions` integer NOT NULL, CONSTRAINT `transactions_booths_transactions` FOREIGN KEY (`booth_transactions`) REFERENCES `booths` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_products_transactions` FOREIGN KEY (`product_transactions`) REFERENCES `products` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_users_transactions` FOREIGN KEY (`user_transactions`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "transaction_timestamp" to table: "transactions"
+CREATE INDEX `transaction_timestamp` ON `transactions` (`timestamp`);
+-- create index "transaction_booth_transactions_timestamp" to table: "transactions"
+CREATE INDEX `transaction_booth_transactions_timestamp` ON `transactions` (`booth_transactions`, `timestamp`);
+-- create index "transaction_booth_transactions_fulfillment_status" to table: "transactions"
+CREATE INDEX `transaction_booth_transactions_fulfillment_status` ON `transactions` (`booth_transactions`, `fulfillment_status`);
+-- create "users" table
+CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password` text NOT NULL, `point` integer NOT NULL, `pin` text NOT NULL, `role` text NOT NULL DEFAULT ('USER'));
+-- create index "users_username_key" to table: "users"
+CREATE UNIQUE INDEX `users_username_key` ON `users` (`username`);
+-- create "webhooks" table
+CREATE TABLE `webhooks` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `url` text NOT NULL, `secret` text NOT NULL, `events` json NULL, `enabled` bool NOT NULL DEFAULT (true), `created_at` datetime NOT NULL);
+-- create "webhook_deliveries" table
+CREATE TABLE `webhook_deliveries` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `status` text NOT NULL DEFAULT ('PENDING'), `attempts` integer NOT NULL DEFAULT (0), `next_attempt_at` datetime NOT NULL, `last_status_code` integer NULL, `last_error` text NULL, `delivered_at` datetime NULL, `created_at` datetime NOT NULL, `outbox_event_deliveries` integer NOT NULL, `webhook_deliveries` integer NOT NULL, CONSTRAINT `webhook_deliveries_outbox_events_deliveries` FOREIGN KEY (`outbox_event_deliveries`) REFERENCES `outbox_events` (`id`) ON DELETE NO ACTION, CONSTRAINT `webhook_deliveries_webhooks_deliveries` FOREIGN KEY (`webhook_deliveries`) REFERENCES `webhooks` (`id`) ON DELETE NO ACTION);
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX `webhookdelivery_status_next_attempt_at` ON `webhook_deliveries` (`status`, `next_attempt_at`);
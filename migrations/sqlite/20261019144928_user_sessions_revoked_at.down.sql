@@ -0,0 +1,2 @@
+-- reverse: add column "sessions_revoked_at" to table: "users"
+ALTER TABLE `users` DROP COLUMN `sessions_revoked_at`;
@@ -0,0 +1,2 @@
+-- add column "sessions_revoked_at" to table: "users"
+ALTER TABLE `users` ADD COLUMN `sessions_revoked_at` datetime NULL;
@@ -0,0 +1,6 @@
+-- reverse: add column "class" to table: "users"
+ALTER TABLE `users` DROP COLUMN `class`;
+-- reverse: add column "grade" to table: "users"
+ALTER TABLE `users` DROP COLUMN `grade`;
+-- reverse: add column "name" to table: "users"
+ALTER TABLE `users` DROP COLUMN `name`;
@@ -0,0 +1,6 @@
+-- add column "name" to table: "users"
+ALTER TABLE `users` ADD COLUMN `name` text NULL;
+-- add column "grade" to table: "users"
+ALTER TABLE `users` ADD COLUMN `grade` integer NULL;
+-- add column "class" to table: "users"
+ALTER TABLE `users` ADD COLUMN `class` integer NULL;
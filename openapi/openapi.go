@@ -0,0 +1,241 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"somapay-backend/apierror"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Explorer 는 /openapi.json 을 읽어 API 를 둘러보고 직접 호출해 볼 수 있는 페이지다.
+// 외부 CDN 없이 동작하도록 한 파일에 담았다.
+//
+//go:embed explorer.html
+var Explorer []byte
+
+// Schema 는 OpenAPI 스키마 객체다. 필드가 많고 조합이 다양해 map 으로 둔다.
+type Schema map[string]any
+
+type Parameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      Schema `json:"schema"`
+}
+
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Operation struct {
+	Tags        []string               `json:"tags"`
+	Summary     string                 `json:"summary"`
+	Description string                 `json:"description,omitempty"`
+	Security    *[]map[string][]string `json:"security,omitempty"`
+	Parameters  []Parameter            `json:"parameters,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]Response    `json:"responses"`
+
+	errs      []*apierror.Error
+	drainless bool
+}
+
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       map[string]string                `json:"info"`
+	Tags       []map[string]string              `json:"tags"`
+	Security   []map[string][]string            `json:"security"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components map[string]any                   `json:"components"`
+}
+
+var (
+	specOnce sync.Once
+	specJSON []byte
+)
+
+// JSON 은 API 명세를 JSON 으로 돌려준다. 처음 한 번만 만든다.
+func JSON() []byte {
+	specOnce.Do(func() {
+		b, err := json.Marshal(Spec())
+		if err != nil {
+			panic(err)
+		}
+		specJSON = b
+	})
+	return specJSON
+}
+
+// Spec 은 setupRoutes 에 등록한 모든 라우트의 명세를 만든다.
+// 라우트를 추가하면 paths.go 에도 추가해야 한다. 빠지면 main_test.go 가 실패한다.
+func Spec() *Document {
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: map[string]string{
+			"title":   "SomaPay API",
+			"version": "1.0.0",
+			"description": "축제 부스 결제 서버 API.\n\n" +
+				"인증이 필요한 요청은 `POST /login` 이 돌려준 token 을 `Authorization` 헤더에 그대로(Bearer 없이) 넣는다.\n" +
+				"에러는 `{\"code\", \"message\", \"details\"}` 형태로 돌려주며, 메시지 언어는 `Accept-Language`(ko, en)로 고른다.",
+		},
+		Tags:     tags,
+		Security: []map[string][]string{{"token": {}}},
+		Paths:    map[string]map[string]*Operation{},
+		Components: map[string]any{
+			"securitySchemes": map[string]any{
+				"token": map[string]string{
+					"type":        "apiKey",
+					"in":          "header",
+					"name":        "Authorization",
+					"description": "POST /login 이 돌려준 token",
+				},
+			},
+			"schemas": schemas(),
+		},
+	}
+
+	for _, r := range routes() {
+		op := r.op
+		if op.Security == nil {
+			op.errs = append(op.errs, apierror.MissingToken, apierror.InvalidToken, apierror.SessionRevoked)
+		}
+		if !op.drainless {
+			op.errs = append(op.errs, apierror.ShuttingDown)
+		}
+		op.errs = append(op.errs, apierror.Internal)
+		addErrors(op)
+
+		if doc.Paths[r.path] == nil {
+			doc.Paths[r.path] = map[string]*Operation{}
+		}
+		doc.Paths[r.path][strings.ToLower(r.method)] = op
+	}
+
+	return doc
+}
+
+// addErrors 는 op 가 돌려줄 수 있는 에러를 상태 코드별로 묶어 응답에 넣는다.
+func addErrors(op *Operation) {
+	byStatus := map[int][]string{}
+	for _, e := range op.errs {
+		codes := byStatus[e.Status]
+		if !containsCode(codes, e.Code) {
+			byStatus[e.Status] = append(codes, e.Code)
+		}
+	}
+
+	for status, codes := range byStatus {
+		sort.Strings(codes)
+		op.Responses[strconv.Itoa(status)] = Response{
+			Description: http.StatusText(status) + ": " + strings.Join(codes, ", "),
+			Content:     jsonContent(ref("Error")),
+		}
+	}
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+type route struct {
+	method string
+	path   string
+	op     *Operation
+}
+
+func op(tag, summary string) *Operation {
+	return &Operation{
+		Tags:      []string{tag},
+		Summary:   summary,
+		Responses: map[string]Response{},
+	}
+}
+
+func (o *Operation) describe(description string) *Operation {
+	o.Description = description
+	return o
+}
+
+// public 은 로그인 없이 부를 수 있는 요청으로 표시한다.
+func (o *Operation) public() *Operation {
+	o.Security = &[]map[string][]string{}
+	return o
+}
+
+// beforeDrain 은 종료 중에도 응답하는 요청으로 표시한다.
+func (o *Operation) beforeDrain() *Operation {
+	o.drainless = true
+	return o
+}
+
+func (o *Operation) params(ps ...Parameter) *Operation {
+	o.Parameters = append(o.Parameters, ps...)
+	return o
+}
+
+func (o *Operation) body(s Schema) *Operation {
+	o.RequestBody = &RequestBody{Required: true, Content: jsonContent(s)}
+	return o
+}
+
+// upload 는 file 필드 하나를 받는 multipart 요청으로 표시한다.
+func (o *Operation) upload(description string) *Operation {
+	o.RequestBody = &RequestBody{
+		Required: true,
+		Content: map[string]MediaType{
+			"multipart/form-data": {Schema: object(props{
+				"file": str(description).with("format", "binary"),
+			}, "file")},
+		},
+	}
+	return o
+}
+
+func (o *Operation) ok(status int, description string, s Schema) *Operation {
+	o.Responses[strconv.Itoa(status)] = Response{Description: description, Content: jsonContent(s)}
+	return o
+}
+
+// file 은 JSON 이 아닌 본문(파일, 텍스트, 스트림)을 돌려주는 응답을 넣는다.
+func (o *Operation) file(description string, contentTypes ...string) *Operation {
+	content := map[string]MediaType{}
+	for _, t := range contentTypes {
+		content[t] = MediaType{Schema: Schema{"type": "string", "format": "binary"}}
+	}
+	o.Responses["200"] = Response{Description: description, Content: content}
+	return o
+}
+
+func (o *Operation) noContent() *Operation {
+	o.Responses["204"] = Response{Description: "삭제됨"}
+	return o
+}
+
+func (o *Operation) errors(errs ...*apierror.Error) *Operation {
+	o.errs = append(o.errs, errs...)
+	return o
+}
+
+func jsonContent(s Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: s}}
+}
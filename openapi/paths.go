@@ -0,0 +1,388 @@
+package openapi
+
+import (
+	"net/http"
+	"somapay-backend/apierror"
+)
+
+var tags = []map[string]string{
+	{"name": "system", "description": "상태 확인, 지표, 문서"},
+	{"name": "auth", "description": "로그인"},
+	{"name": "users", "description": "계정 관리와 명단 가져오기"},
+	{"name": "me", "description": "로그인한 유저 정보"},
+	{"name": "booths", "description": "부스, 매출 통계, 주문 대기열"},
+	{"name": "products", "description": "상품"},
+	{"name": "transactions", "description": "결제, 환불, 주문 처리"},
+	{"name": "charge-requests", "description": "포인트 충전 요청"},
+	{"name": "auto-approval-rules", "description": "충전 요청 자동 승인 규칙"},
+	{"name": "events", "description": "실시간 이벤트 (Server-Sent Events)"},
+	{"name": "webhooks", "description": "외부 시스템으로 보내는 웹훅"},
+	{"name": "exports", "description": "CSV/XLSX 내보내기"},
+	{"name": "admin", "description": "운영 통계와 감사 로그"},
+}
+
+func pathParam(name, description string) Parameter {
+	return Parameter{Name: name, In: "path", Required: true, Description: description, Schema: Schema{"type": "integer"}}
+}
+
+func query(name string, s Schema, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: s}
+}
+
+var (
+	idParam     = pathParam("id", "")
+	limitParam  = query("limit", Schema{"type": "integer", "default": 50, "maximum": 200}, "한 페이지 개수. 200 을 넘으면 200 으로 줄인다.")
+	cursorParam = query("cursor", Schema{"type": "string"}, "이전 응답의 next_cursor")
+	fromParam   = query("from", Schema{"type": "string", "format": "date-time"}, "RFC 3339 시각 (포함)")
+	toParam     = query("to", Schema{"type": "string", "format": "date-time"}, "RFC 3339 시각 (제외)")
+)
+
+// windowParams 는 최대 7일까지 조회하는 통계 기간이다.
+func windowParams(defaultSpan string) []Parameter {
+	return []Parameter{
+		query("from", Schema{"type": "string", "format": "date-time"}, "기본값 to 에서 "+defaultSpan+" 전"),
+		query("to", Schema{"type": "string", "format": "date-time"}, "기본값 지금"),
+	}
+}
+
+func exportFormat() Parameter {
+	return query("format", Schema{"type": "string", "enum": exportFormats, "default": "csv"}, "")
+}
+
+func transactionFilterParams() []Parameter {
+	return []Parameter{
+		query("status", enum("", transactionStatus...), ""),
+		query("booth_id", Schema{"type": "integer"}, ""),
+		query("product_id", Schema{"type": "integer"}, ""),
+		query("user_id", Schema{"type": "integer"}, ""),
+		fromParam,
+		toParam,
+	}
+}
+
+func chargeRequestFilterParams() []Parameter {
+	return []Parameter{
+		query("status", enum("", chargeStatus...), ""),
+		query("payment_method", enum("", paymentMethods...), ""),
+		query("depositor", Schema{"type": "string"}, "입금자명"),
+		query("user_id", Schema{"type": "integer"}, "관리자만 쓸 수 있다"),
+		query("min_amount", Schema{"type": "integer"}, ""),
+		query("max_amount", Schema{"type": "integer"}, ""),
+		fromParam,
+		toParam,
+	}
+}
+
+const (
+	csvType  = "text/csv"
+	xlsxType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+func routes() []route {
+	return []route{
+		// 상태 확인, 문서
+		{http.MethodGet, "/healthz", op("system", "프로세스 상태").
+			public().beforeDrain().
+			ok(200, "살아 있음", ref("Status"))},
+		{http.MethodGet, "/readyz", op("system", "요청을 받을 준비가 됐는지").
+			describe("DB 에 닿지 않거나, 스키마가 맞지 않거나, 종료 중이면 503 이다.").
+			public().beforeDrain().
+			ok(200, "준비됨", ref("Status")).
+			errors(apierror.Unavailable)},
+		{http.MethodGet, "/", op("system", "핑").
+			public().
+			file("Hello, World!", "text/plain")},
+		{http.MethodGet, "/openapi.json", op("system", "이 API 명세").
+			public().
+			ok(200, "OpenAPI 3 문서", Schema{"type": "object"})},
+		{http.MethodGet, "/docs", op("system", "API 탐색 페이지").
+			public().
+			file("HTML 페이지", "text/html")},
+		{http.MethodGet, "/metrics", op("system", "Prometheus 지표").
+			describe("metrics.allowed_networks 에서 온 요청은 토큰 없이, 그 밖에는 관리자만 볼 수 있다.").
+			file("Prometheus 텍스트 형식", "text/plain").
+			errors(apierror.Forbidden)},
+
+		// 로그인
+		{http.MethodPost, "/login", op("auth", "로그인").
+			public().
+			body(ref("LoginRequest")).
+			ok(200, "세션 토큰", ref("LoginResponse")).
+			errors(apierror.InvalidBody, apierror.InvalidCredentials)},
+
+		// 유저
+		{http.MethodPost, "/users", op("users", "계정 만들기 (관리자)").
+			body(ref("UserCreate")).
+			ok(200, "만든 계정", ref("User")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.UsernameTaken)},
+		{http.MethodPost, "/users/import", op("users", "명단 CSV 로 계정 일괄 등록 (관리자)").
+			describe("dry_run 이면 검사 결과만 돌려준다. 잘못된 줄이 하나라도 있으면 아무 계정도 만들지 않고 422 의 details 에 RosterPreview 를 싣는다. "+
+				"만든 계정의 비밀번호와 PIN 은 sheet 주소에서 한 번만 내려받을 수 있다.").
+			params(query("dry_run", Schema{"type": "boolean"}, "")).
+			upload("student_number,name,grade,class[,role] 헤더가 있는 CSV").
+			ok(200, "dry_run 결과", ref("RosterPreview")).
+			ok(201, "등록 결과", ref("RosterImportResult")).
+			errors(apierror.Forbidden, apierror.MissingFile, apierror.InvalidFile, apierror.InvalidRoster, apierror.UsernameTaken)},
+		{http.MethodGet, "/users/import/sheets/{sheet_id}", op("users", "계정표 내려받기 (관리자, 한 번만)").
+			params(
+				Parameter{Name: "sheet_id", In: "path", Required: true, Schema: Schema{"type": "string"}},
+				query("format", Schema{"type": "string", "enum": credentialFormats, "default": "csv"}, "html 은 인쇄용 카드"),
+			).
+			file("계정표", csvType, "text/html").
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.SheetNotFound)},
+		{http.MethodGet, "/users/{id}", op("users", "계정 조회 (본인 또는 관리자)").
+			params(idParam).
+			ok(200, "계정", ref("User")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.UserNotFound)},
+		{http.MethodPatch, "/users/{id}", op("users", "비밀번호, PIN 바꾸기 (본인 또는 관리자)").
+			params(idParam).
+			body(ref("UserUpdate")).
+			ok(200, "바뀐 계정", ref("User")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.InvalidBody)},
+		{http.MethodPost, "/users/{id}/adjustments", op("users", "잔액 조정 (관리자)").
+			params(idParam).
+			body(ref("AdjustmentCreate")).
+			ok(200, "조정 기록", ref("Adjustment")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.InvalidBody, apierror.InvalidValue, apierror.UserNotFound, apierror.InsufficientBalance)},
+		{http.MethodGet, "/users/{id}/adjustments", op("users", "잔액 조정 기록 (본인 또는 관리자)").
+			params(idParam).
+			ok(200, "최신순 조정 기록", array(ref("Adjustment"))).
+			errors(apierror.InvalidParameter, apierror.Forbidden)},
+
+		// 내 정보
+		{http.MethodGet, "/me", op("me", "내 정보").
+			ok(200, "로그인한 유저", ref("Me"))},
+		{http.MethodGet, "/me/activity", op("me", "내 잔액 변동 내역").
+			describe("충전, 결제, 환불, 관리자 조정을 최신순으로 합친 목록. 각 항목에 그 시점의 잔액이 붙는다.").
+			params(limitParam, cursorParam).
+			ok(200, "내역", ref("ActivityPage")).
+			errors(apierror.InvalidParameter)},
+
+		// 부스
+		{http.MethodPost, "/booths", op("booths", "부스 만들기 (관리자)").
+			body(ref("BoothCreate")).
+			ok(200, "만든 부스", ref("Booth")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.UserNotFound, apierror.BoothConflict)},
+		{http.MethodGet, "/booths", op("booths", "부스 목록").
+			ok(200, "부스 목록", array(ref("Booth")))},
+		{http.MethodGet, "/booths/{id}", op("booths", "부스 조회 (관리자 또는 부스 호스트)").
+			params(idParam).
+			ok(200, "부스", ref("Booth")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.BoothNotFound)},
+		{http.MethodGet, "/booths/{id}/stats", op("booths", "부스 매출 통계 (관리자 또는 부스 호스트)").
+			describe("기간은 최대 7일이다.").
+			params(append([]Parameter{idParam}, windowParams("24시간")...)...).
+			ok(200, "매출 통계", ref("BoothStats")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.WindowTooLarge)},
+		{http.MethodGet, "/booths/{id}/orders", op("booths", "주문 대기열 (관리자 또는 부스 호스트)").
+			describe("아직 찾아가지 않은 주문을 접수 순으로 돌려준다.").
+			params(idParam, query("fulfillment_status", enum("", fulfillmentStatus...), "")).
+			ok(200, "주문 목록", array(ref("Transaction"))).
+			errors(apierror.InvalidParameter, apierror.Forbidden)},
+		{http.MethodPatch, "/booths/{id}", op("booths", "부스 수정 (관리자)").
+			params(idParam).
+			body(ref("BoothUpdate")).
+			ok(200, "바뀐 부스", ref("Booth")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.InvalidBody, apierror.UserNotFound)},
+		{http.MethodDelete, "/booths/{id}", op("booths", "부스 삭제 (관리자)").
+			params(idParam).
+			noContent().
+			errors(apierror.Forbidden, apierror.InvalidParameter)},
+
+		// 상품
+		{http.MethodGet, "/products", op("products", "전체 상품 목록 (관리자)").
+			ok(200, "상품 목록", array(ref("Product"))).
+			errors(apierror.Forbidden)},
+		{http.MethodGet, "/products/booth/{booth_id}", op("products", "부스의 상품 목록").
+			params(pathParam("booth_id", "")).
+			ok(200, "상품 목록", array(ref("Product"))).
+			errors(apierror.InvalidParameter)},
+		{http.MethodGet, "/products/{id}", op("products", "상품 조회").
+			params(idParam).
+			ok(200, "상품", ref("Product")).
+			errors(apierror.InvalidParameter, apierror.ProductNotFound)},
+		{http.MethodPost, "/products", op("products", "상품 만들기 (관리자 또는 부스 호스트)").
+			body(ref("ProductCreate")).
+			ok(200, "만든 상품", ref("Product")).
+			errors(apierror.InvalidBody, apierror.Forbidden)},
+		{http.MethodPatch, "/products/{id}", op("products", "상품 수정 (관리자 또는 부스 호스트)").
+			params(idParam).
+			body(ref("ProductUpdate")).
+			ok(200, "바뀐 상품", ref("Product")).
+			errors(apierror.InvalidParameter, apierror.InvalidBody, apierror.Forbidden, apierror.ProductNotFound, apierror.BoothNotFound)},
+		{http.MethodDelete, "/products/{id}", op("products", "상품 삭제 (관리자 또는 부스 호스트)").
+			params(idParam).
+			noContent().
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.ProductNotFound, apierror.BoothNotFound)},
+
+		// 충전 요청
+		{http.MethodPost, "/charge-requests", op("charge-requests", "충전 요청 (호스트 제외)").
+			describe("자동 승인 규칙에 맞으면 바로 APPROVED 로 만들어진다.").
+			body(ref("ChargeRequestCreate")).
+			ok(200, "만든 충전 요청", ref("ChargeRequest")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.InvalidValue, apierror.AmountLimitExceeded)},
+		{http.MethodGet, "/charge-requests", op("charge-requests", "충전 요청 목록").
+			describe("관리자가 아니면 자기 요청만 보인다. 최신순.").
+			params(append(chargeRequestFilterParams(), limitParam, cursorParam)...).
+			ok(200, "충전 요청 목록", ref("ChargeRequestPage")).
+			errors(apierror.InvalidParameter)},
+		{http.MethodPost, "/charge-requests/batch", op("charge-requests", "충전 요청 일괄 처리 (관리자)").
+			describe("한 번에 500개까지. 이미 처리됐거나 없는 요청은 건너뛰고 results 에 이유를 남긴다.").
+			body(ref("BatchDecision")).
+			ok(200, "요청별 결과", ref("BatchDecisionResult")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.InvalidValue)},
+		{http.MethodPost, "/charge-requests/bank-import", op("charge-requests", "은행 입금 내역과 대기 중인 요청 맞춰 보기 (관리자)").
+			describe("승인하지는 않는다. 결과를 보고 /charge-requests/batch 로 처리한다.").
+			upload("은행 거래내역 CSV").
+			ok(200, "맞춰 본 결과", ref("BankImportResult")).
+			errors(apierror.Forbidden, apierror.MissingFile, apierror.InvalidFile)},
+		{http.MethodGet, "/charge-requests/{id}", op("charge-requests", "충전 요청 조회 (본인 또는 관리자)").
+			params(idParam).
+			ok(200, "충전 요청", ref("ChargeRequest")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.ChargeRequestNotFound)},
+		{http.MethodPatch, "/charge-requests/{id}", op("charge-requests", "충전 요청 승인, 거절 (관리자)").
+			params(idParam).
+			body(ref("ChargeRequestDecision")).
+			ok(200, "처리된 충전 요청", ref("ChargeRequest")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.InvalidBody, apierror.InvalidValue, apierror.ChargeRequestNotFound, apierror.ChargeRequestAlreadyDecided)},
+		{http.MethodPost, "/charge-requests/{id}/cancel", op("charge-requests", "내 충전 요청 취소").
+			params(idParam).
+			ok(200, "취소된 충전 요청", ref("ChargeRequest")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.ChargeRequestNotFound, apierror.ChargeRequestAlreadyDecided)},
+
+		// 자동 승인 규칙
+		{http.MethodGet, "/auto-approval-rules", op("auto-approval-rules", "규칙 목록 (관리자)").
+			ok(200, "규칙 목록", array(ref("AutoApprovalRule"))).
+			errors(apierror.Forbidden)},
+		{http.MethodPost, "/auto-approval-rules", op("auto-approval-rules", "규칙 만들기 (관리자)").
+			body(ref("AutoApprovalRuleCreate")).
+			ok(200, "만든 규칙", ref("AutoApprovalRule")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.InvalidValue, apierror.RuleNeedsCondition)},
+		{http.MethodPatch, "/auto-approval-rules/{id}", op("auto-approval-rules", "규칙 수정 (관리자)").
+			params(idParam).
+			body(ref("AutoApprovalRuleUpdate")).
+			ok(200, "바뀐 규칙", ref("AutoApprovalRule")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.InvalidBody, apierror.InvalidValue, apierror.RuleNeedsCondition, apierror.RuleNotFound)},
+		{http.MethodDelete, "/auto-approval-rules/{id}", op("auto-approval-rules", "규칙 삭제 (관리자)").
+			params(idParam).
+			noContent().
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.RuleNotFound)},
+
+		// 이벤트
+		{http.MethodGet, "/events", op("events", "실시간 이벤트 구독").
+			describe("EventSource 는 헤더를 못 넣으므로 token 쿼리로도 인증할 수 있다. "+
+				"이벤트: balance.changed, transaction.created, transaction.refunded, order.updated, charge_request.decided. "+
+				"놓친 이벤트가 보관 범위를 넘으면 reset 이벤트를 먼저 보낸다.").
+			params(
+				query("token", Schema{"type": "string"}, "Authorization 헤더 대신 쓰는 세션 토큰"),
+				query("last_event_id", Schema{"type": "integer"}, "Last-Event-ID 헤더 대신 쓸 수 있다"),
+			).
+			file("이벤트 스트림", "text/event-stream").
+			errors(apierror.InvalidParameter)},
+
+		// 웹훅
+		{http.MethodGet, "/webhooks", op("webhooks", "웹훅 목록 (관리자)").
+			ok(200, "웹훅 목록", array(ref("Webhook"))).
+			errors(apierror.Forbidden)},
+		{http.MethodPost, "/webhooks", op("webhooks", "웹훅 등록 (관리자)").
+			describe("secret 은 이 응답에서만 볼 수 있다.").
+			body(ref("WebhookCreate")).
+			ok(200, "등록한 웹훅과 secret", ref("WebhookWithSecret")).
+			errors(apierror.Forbidden, apierror.InvalidBody, apierror.InvalidValue)},
+		{http.MethodGet, "/webhooks/deliveries", op("webhooks", "웹훅 전송 기록 (관리자)").
+			params(
+				query("status", enum("", deliveryStatus...), ""),
+				query("webhook_id", Schema{"type": "integer"}, ""),
+				limitParam,
+				cursorParam,
+			).
+			ok(200, "전송 기록", ref("WebhookDeliveryPage")).
+			errors(apierror.Forbidden, apierror.InvalidParameter)},
+		{http.MethodPost, "/webhooks/deliveries/{id}/retry", op("webhooks", "실패한 전송 다시 보내기 (관리자)").
+			params(idParam).
+			ok(200, "다시 대기열에 넣은 전송", ref("WebhookDelivery")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.DeliveryNotFound, apierror.DeliveryNotDead)},
+		{http.MethodPatch, "/webhooks/{id}", op("webhooks", "웹훅 수정 (관리자)").
+			params(idParam).
+			body(ref("WebhookUpdate")).
+			ok(200, "바뀐 웹훅. rotate_secret 이면 secret 도 있다.", ref("WebhookWithSecret")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.InvalidBody, apierror.InvalidValue, apierror.WebhookNotFound)},
+		{http.MethodDelete, "/webhooks/{id}", op("webhooks", "웹훅 삭제 (관리자)").
+			params(idParam).
+			noContent().
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.WebhookNotFound)},
+
+		// 내보내기
+		{http.MethodGet, "/exports/transactions", op("exports", "거래 내보내기").
+			describe("거래 목록과 같은 조건과 권한 범위를 쓴다.").
+			params(append(transactionFilterParams(), exportFormat())...).
+			file("거래 파일", csvType, xlsxType).
+			errors(apierror.InvalidParameter)},
+		{http.MethodGet, "/exports/charge-requests", op("exports", "충전 요청 내보내기").
+			describe("충전 요청 목록과 같은 조건과 권한 범위를 쓴다.").
+			params(append(chargeRequestFilterParams(), exportFormat())...).
+			file("충전 요청 파일", csvType, xlsxType).
+			errors(apierror.InvalidParameter)},
+		{http.MethodGet, "/exports/balances", op("exports", "잔액 내보내기 (관리자)").
+			params(query("role", enum("", roles...), ""), exportFormat()).
+			file("잔액 파일", csvType, xlsxType).
+			errors(apierror.Forbidden, apierror.InvalidParameter)},
+
+		// 운영
+		{http.MethodGet, "/analytics", op("admin", "축제 전체 통계 (관리자)").
+			describe("기간은 최대 7일이다.").
+			params(windowParams("24시간")...).
+			ok(200, "통계", ref("Analytics")).
+			errors(apierror.Forbidden, apierror.InvalidParameter, apierror.WindowTooLarge)},
+		{http.MethodGet, "/audit-logs", op("admin", "감사 로그 (관리자)").
+			params(
+				query("entity_type", enum("", auditEntityTypes...), ""),
+				query("entity_id", Schema{"type": "integer"}, ""),
+				query("action", enum("", auditActions...), ""),
+				query("actor_id", Schema{"type": "integer"}, ""),
+				query("field", Schema{"type": "string"}, "이 필드가 바뀐 기록만"),
+				fromParam,
+				toParam,
+				limitParam,
+				cursorParam,
+			).
+			ok(200, "최신순 감사 로그", ref("AuditLogPage")).
+			errors(apierror.Forbidden, apierror.InvalidParameter)},
+
+		// 거래
+		{http.MethodPost, "/transactions", op("transactions", "결제").
+			describe("잔액 차감과 주문 번호 발급이 한 트랜잭션에서 일어난다.").
+			body(ref("TransactionCreate")).
+			ok(200, "결제된 거래", ref("Transaction")).
+			errors(apierror.InvalidBody, apierror.InvalidValue, apierror.QuantityLimitExceeded, apierror.InvalidPIN, apierror.ProductNotFound, apierror.AmountLimitExceeded, apierror.InsufficientBalance)},
+		{http.MethodGet, "/transactions", op("transactions", "거래 목록").
+			describe("관리자가 아니면 자기 거래와 (호스트라면) 자기 부스의 거래만 보인다.").
+			params(append(transactionFilterParams(),
+				query("sort", Schema{"type": "string", "enum": transactionSorts, "default": "timestamp"}, ""),
+				query("order", Schema{"type": "string", "enum": []string{"asc", "desc"}, "default": "desc"}, ""),
+				limitParam,
+				cursorParam,
+			)...).
+			ok(200, "거래 목록", ref("TransactionPage")).
+			errors(apierror.InvalidParameter)},
+		{http.MethodGet, "/transactions/{id}", op("transactions", "거래 조회 (구매자, 부스 호스트, 관리자)").
+			params(idParam).
+			ok(200, "거래", ref("Transaction")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.TransactionNotFound)},
+		{http.MethodPost, "/transactions/{id}/refund", op("transactions", "환불 (관리자 또는 부스 호스트)").
+			describe("SUCCESS 인 거래만 환불할 수 있고, 구매자 잔액을 결제 금액만큼 돌려준다. 같은 거래를 두 번 환불하면 409 다.").
+			params(idParam).
+			ok(200, "환불된 거래", ref("Transaction")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.TransactionNotFound, apierror.TransactionNotRefundable)},
+		{http.MethodGet, "/transactions/{id}/order", op("transactions", "주문 진행 상태 (구매자, 부스 호스트, 관리자)").
+			params(idParam).
+			ok(200, "주문 상태", ref("OrderStatus")).
+			errors(apierror.InvalidParameter, apierror.Forbidden, apierror.TransactionNotFound)},
+		{http.MethodPatch, "/transactions/{id}/fulfillment", op("transactions", "주문 처리 단계 바꾸기 (관리자 또는 부스 호스트)").
+			params(idParam).
+			body(ref("FulfillmentUpdate")).
+			ok(200, "바뀐 거래", ref("Transaction")).
+			errors(apierror.InvalidParameter, apierror.InvalidBody, apierror.InvalidValue, apierror.Forbidden, apierror.TransactionNotFound,
+				apierror.TransactionNotFulfillable, apierror.FulfillmentBackward, apierror.OrderChanged)},
+	}
+}
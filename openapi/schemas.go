@@ -0,0 +1,475 @@
+package openapi
+
+import "somapay-backend/apierror"
+
+type props map[string]Schema
+
+func ref(name string) Schema {
+	return Schema{"$ref": "#/components/schemas/" + name}
+}
+
+func str(description string) Schema {
+	return withDescription(Schema{"type": "string"}, description)
+}
+
+func integer(description string) Schema {
+	return withDescription(Schema{"type": "integer"}, description)
+}
+
+func boolean(description string) Schema {
+	return withDescription(Schema{"type": "boolean"}, description)
+}
+
+func dateTime(description string) Schema {
+	return withDescription(Schema{"type": "string", "format": "date-time"}, description)
+}
+
+func enum(description string, values ...string) Schema {
+	return withDescription(Schema{"type": "string", "enum": values}, description)
+}
+
+func array(items Schema) Schema {
+	return Schema{"type": "array", "items": items}
+}
+
+func object(p props, required ...string) Schema {
+	s := Schema{"type": "object", "properties": p}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// page 는 커서 기반 목록 응답이다.
+func page(item string) Schema {
+	return object(props{
+		"items":       array(ref(item)),
+		"next_cursor": str("다음 페이지 커서. 마지막 페이지면 없다."),
+		"total":       integer("조건에 맞는 전체 개수. 거래 목록에만 있다."),
+	}, "items")
+}
+
+func withDescription(s Schema, description string) Schema {
+	if description != "" {
+		s["description"] = description
+	}
+	return s
+}
+
+// with 는 키 하나를 더한 복사본을 돌려준다.
+func (s Schema) with(key string, value any) Schema {
+	c := make(Schema, len(s)+1)
+	for k, v := range s {
+		c[k] = v
+	}
+	c[key] = value
+	return c
+}
+
+func (s Schema) nullable() Schema {
+	return s.with("nullable", true)
+}
+
+var (
+	roles              = []string{"USER", "HOST", "ADMIN"}
+	transactionStatus  = []string{"SUCCESS", "REFUNDED"}
+	fulfillmentStatus  = []string{"RECEIVED", "PREPARING", "READY", "PICKED_UP"}
+	chargeStatus       = []string{"PENDING", "APPROVED", "REJECTED", "CANCELED"}
+	paymentMethods     = []string{"CASH", "BANK_TRANSFER"}
+	deliveryStatus     = []string{"PENDING", "DELIVERED", "DEAD"}
+	webhookEventTypes  = []string{"transaction.created", "transaction.refunded", "charge_request.decided", "user.created"}
+	auditEntityTypes   = []string{"User", "Booth", "Product", "ChargeRequest", "Transaction"}
+	auditActions       = []string{"CREATE", "UPDATE", "DELETE"}
+	transactionSorts   = []string{"timestamp", "amount", "id"}
+	activityTypes      = []string{"adjustment", "charge", "purchase", "refund"}
+	adjustmentReasons  = []string{"CORRECTION", "COMPENSATION", "PRIZE", "PENALTY"}
+	batchResults       = []string{"applied", "already_decided", "not_found"}
+	decisionStatus     = []string{"APPROVED", "REJECTED"}
+	exportFormats      = []string{"csv", "xlsx"}
+	credentialFormats  = []string{"csv", "html"}
+	fulfillmentChanges = []string{"PREPARING", "READY", "PICKED_UP"}
+)
+
+// errorCodes 는 apierror 에 정의된 코드 전부다.
+func errorCodes() []string {
+	var codes []string
+	for _, e := range apierror.All() {
+		codes = append(codes, e.Code)
+	}
+	return append(codes, apierror.HTTPErrorCode)
+}
+
+func schemas() map[string]Schema {
+	return map[string]Schema{
+		"Error": object(props{
+			"code":       enum("클라이언트가 분기에 쓰는 고정된 에러 코드", errorCodes()...),
+			"message":    str("Accept-Language 에 맞춘 사람이 읽을 메시지"),
+			"details":    withDescription(Schema{}, "에러에 따라 붙는 추가 정보. INVALID_PARAMETER 는 {\"param\"}, INVALID_FIELD 는 {\"field\"}."),
+			"request_id": str("X-Request-ID 와 같은 값. 문의할 때 알려 주면 로그를 찾을 수 있다."),
+		}, "code", "message"),
+		"Status": object(props{"status": str("")}, "status"),
+
+		// 인증
+		"LoginRequest": object(props{
+			"student_number": str("학번(아이디)"),
+			"password":       str(""),
+		}, "student_number", "password"),
+		"LoginResponse": object(props{
+			"userId": integer(""),
+			"token":  str("Authorization 헤더에 넣을 세션 토큰"),
+		}, "userId", "token"),
+
+		// 유저
+		"User": object(props{
+			"id":                  integer(""),
+			"username":            str("학번"),
+			"point":               integer("잔액"),
+			"role":                enum("", roles...),
+			"name":                str("명단으로 등록한 경우의 이름"),
+			"grade":               integer(""),
+			"class":               integer(""),
+			"sessions_revoked_at": dateTime(""),
+			"edges":               object(props{"booth": ref("Booth")}),
+		}),
+		"UserCreate": object(props{
+			"username": str("학번"),
+			"password": str(""),
+			"pin":      str("결제 PIN"),
+			"role":     enum("", roles...),
+		}, "username", "password", "pin"),
+		"UserUpdate": object(props{
+			"password": str(""),
+			"pin":      str(""),
+		}),
+		"Me": object(props{
+			"id":       integer(""),
+			"username": str(""),
+			"role":     enum("", roles...),
+			"point":    integer(""),
+			"booth":    ref("Booth").nullable(),
+		}),
+		"ActivityItem": object(props{
+			"type":     enum("", activityTypes...),
+			"id":       integer("충전 요청, 거래, 조정의 id"),
+			"time":     dateTime(""),
+			"amount":   integer("잔액 변화량"),
+			"balance":  integer("이 항목이 반영된 직후 잔액"),
+			"status":   str(""),
+			"booth":    str(""),
+			"product":  str(""),
+			"quantity": integer(""),
+			"reason":   str(""),
+			"note":     str(""),
+		}, "type", "id", "time", "amount", "balance"),
+		"ActivityPage": page("ActivityItem"),
+
+		// 명단 가져오기
+		"RosterRow": object(props{
+			"line":           integer(""),
+			"student_number": str(""),
+			"name":           str(""),
+			"grade":          integer(""),
+			"class":          integer(""),
+			"role":           enum("", roles...),
+		}),
+		"RosterError": object(props{
+			"line":   integer(""),
+			"field":  str(""),
+			"reason": str(""),
+		}),
+		"RosterPreview": object(props{
+			"rows":   array(ref("RosterRow")),
+			"errors": array(ref("RosterError")),
+		}),
+		"RosterImportResult": object(props{
+			"created": integer(""),
+			"sheet": object(props{
+				"id":         str(""),
+				"expires_at": dateTime(""),
+				"csv_url":    str(""),
+				"html_url":   str(""),
+			}),
+		}),
+
+		// 조정
+		"Adjustment": object(props{
+			"id":        integer(""),
+			"amount":    integer("음수면 차감"),
+			"reason":    enum("", adjustmentReasons...),
+			"note":      str(""),
+			"timestamp": dateTime(""),
+			"edges":     object(props{"user": ref("User"), "admin": ref("User")}),
+		}),
+		"AdjustmentCreate": object(props{
+			"amount": integer("음수면 차감. 0 은 안 된다."),
+			"reason": enum("", adjustmentReasons...),
+			"note":   str(""),
+		}, "amount", "reason"),
+
+		// 부스
+		"Booth": object(props{
+			"id":        integer(""),
+			"name":      str(""),
+			"order_seq": integer("마지막으로 발급한 주문 번호"),
+			"edges":     object(props{"user": ref("User")}),
+		}),
+		"BoothCreate": object(props{
+			"name":     str(""),
+			"username": str("부스를 맡을 호스트의 학번"),
+		}, "name", "username"),
+		"BoothUpdate": object(props{
+			"name":     str(""),
+			"username": str(""),
+		}),
+		"BoothStats": object(props{
+			"booth_id":            integer(""),
+			"from":                dateTime(""),
+			"to":                  dateTime(""),
+			"gross_sales":         integer("환불 전 매출"),
+			"refunds":             integer(""),
+			"net_sales":           integer(""),
+			"orders":              integer(""),
+			"refunded_orders":     integer(""),
+			"average_order_value": integer(""),
+			"products": array(object(props{
+				"product_id": integer(""),
+				"name":       str(""),
+				"units":      integer(""),
+				"sales":      integer(""),
+			})),
+			"hourly": array(object(props{
+				"start":  dateTime(""),
+				"sales":  integer(""),
+				"orders": integer(""),
+			})),
+		}),
+
+		// 상품
+		"Product": object(props{
+			"id":          integer(""),
+			"name":        str(""),
+			"description": str(""),
+			"price":       integer(""),
+			"edges":       object(props{"booth": ref("Booth")}),
+		}),
+		"ProductCreate": object(props{
+			"booth_id":    integer(""),
+			"name":        str(""),
+			"description": str(""),
+			"price":       integer(""),
+		}, "booth_id", "name", "price"),
+		"ProductUpdate": object(props{
+			"name":        str(""),
+			"description": str(""),
+			"price":       integer(""),
+		}),
+
+		// 거래와 주문
+		"Transaction": object(props{
+			"id":                 integer(""),
+			"quantity":           integer(""),
+			"amount":             integer(""),
+			"status":             enum("", transactionStatus...),
+			"timestamp":          dateTime(""),
+			"refunded_at":        dateTime(""),
+			"order_number":       integer("부스별 주문 번호"),
+			"fulfillment_status": enum("", fulfillmentStatus...),
+			"user_id":            integer(""),
+			"booth_id":           integer(""),
+			"product_id":         integer(""),
+			"edges": object(props{
+				"user":    ref("User"),
+				"booth":   ref("Booth"),
+				"product": ref("Product"),
+			}),
+		}),
+		"TransactionPage": page("Transaction"),
+		"TransactionCreate": object(props{
+			"product_id": integer(""),
+			"quantity":   integer(""),
+			"pin":        str("결제 PIN"),
+		}, "product_id", "quantity", "pin"),
+		"OrderStatus": object(props{
+			"transaction_id":     integer(""),
+			"booth_id":           integer(""),
+			"booth":              str(""),
+			"product":            str(""),
+			"quantity":           integer(""),
+			"order_number":       integer(""),
+			"status":             enum("", transactionStatus...),
+			"fulfillment_status": enum("", fulfillmentStatus...),
+			"orders_ahead":       integer("앞에 남은 주문 수"),
+		}),
+		"FulfillmentUpdate": object(props{
+			"status": enum("앞으로만 바꿀 수 있다", fulfillmentChanges...),
+		}, "status"),
+
+		// 충전 요청
+		"ChargeRequest": object(props{
+			"id":             integer(""),
+			"amount":         integer(""),
+			"status":         enum("", chargeStatus...),
+			"depositor_name": str(""),
+			"payment_method": enum("", paymentMethods...),
+			"created_at":     dateTime(""),
+			"decided_at":     dateTime(""),
+			"edges": object(props{
+				"user": ref("User"),
+				"rule": ref("AutoApprovalRule"),
+			}),
+		}),
+		"ChargeRequestPage": page("ChargeRequest"),
+		"ChargeRequestCreate": object(props{
+			"amount":         integer(""),
+			"depositor_name": str(""),
+			"payment_method": enum("", paymentMethods...),
+		}, "amount"),
+		"ChargeRequestDecision": object(props{
+			"status": enum("", decisionStatus...),
+		}, "status"),
+		"BatchDecision": object(props{
+			"ids":    array(integer("")).with("maxItems", 500),
+			"status": enum("", decisionStatus...),
+		}, "ids", "status"),
+		"BatchDecisionResult": object(props{
+			"status": enum("", decisionStatus...),
+			"results": array(object(props{
+				"id":     integer(""),
+				"result": enum("", batchResults...),
+			})),
+		}),
+		"BankDeposit": object(props{
+			"line":      integer(""),
+			"date":      str(""),
+			"depositor": str(""),
+			"amount":    integer(""),
+		}),
+		"BankImportResult": object(props{
+			"matches": array(object(props{
+				"deposit":        ref("BankDeposit"),
+				"charge_request": ref("ChargeRequest"),
+				"ambiguous":      boolean("같은 금액과 이름의 요청이 여러 개라 가장 오래된 요청을 고른 경우"),
+			})),
+			"unmatched_deposits": array(ref("BankDeposit")),
+			"unmatched_requests": array(ref("ChargeRequest")),
+			"invalid_rows": array(object(props{
+				"line":   integer(""),
+				"reason": str(""),
+			})),
+		}),
+
+		// 자동 승인 규칙
+		"AutoApprovalRule": object(props{
+			"id":                  integer(""),
+			"name":                str(""),
+			"max_amount":          integer(""),
+			"role":                enum("", roles...),
+			"max_daily_approvals": integer(""),
+			"enabled":             boolean(""),
+			"created_at":          dateTime(""),
+		}),
+		"AutoApprovalRuleCreate": object(props{
+			"name":                str(""),
+			"max_amount":          integer(""),
+			"role":                enum("", roles...),
+			"max_daily_approvals": integer(""),
+			"enabled":             boolean("기본값 true"),
+		}, "name"),
+		"AutoApprovalRuleUpdate": object(props{
+			"name":                      str(""),
+			"max_amount":                integer(""),
+			"role":                      enum("빈 문자열이면 역할 조건을 지운다", append([]string{""}, roles...)...),
+			"max_daily_approvals":       integer(""),
+			"enabled":                   boolean(""),
+			"clear_max_amount":          boolean("true 면 금액 조건을 지운다"),
+			"clear_max_daily_approvals": boolean("true 면 하루 승인 횟수 조건을 지운다"),
+		}),
+
+		// 웹훅
+		"Webhook": object(props{
+			"id":         integer(""),
+			"url":        str(""),
+			"events":     array(enum("", webhookEventTypes...)),
+			"enabled":    boolean(""),
+			"created_at": dateTime(""),
+		}),
+		"WebhookCreate": object(props{
+			"url":    str(""),
+			"events": array(enum("", webhookEventTypes...)),
+		}, "url", "events"),
+		"WebhookUpdate": object(props{
+			"url":           str(""),
+			"events":        array(enum("", webhookEventTypes...)),
+			"enabled":       boolean(""),
+			"rotate_secret": boolean("true 면 새 secret 을 발급해 응답에 한 번 싣는다"),
+		}),
+		"WebhookWithSecret": object(props{
+			"webhook": ref("Webhook"),
+			"secret":  str("서명 검증에 쓰는 secret. 발급할 때만 응답에 있다."),
+		}, "webhook"),
+		"WebhookDelivery": object(props{
+			"id":               integer(""),
+			"status":           enum("", deliveryStatus...),
+			"attempts":         integer(""),
+			"next_attempt_at":  dateTime(""),
+			"last_status_code": integer(""),
+			"last_error":       str(""),
+			"delivered_at":     dateTime(""),
+			"created_at":       dateTime(""),
+			"edges": object(props{
+				"event": object(props{
+					"id":         integer(""),
+					"type":       enum("", webhookEventTypes...),
+					"payload":    str("JSON 문자열"),
+					"dispatched": boolean(""),
+					"created_at": dateTime(""),
+				}),
+				"webhook": ref("Webhook"),
+			}),
+		}),
+		"WebhookDeliveryPage": page("WebhookDelivery"),
+
+		// 통계와 감사 로그
+		"Analytics": object(props{
+			"from":                dateTime(""),
+			"to":                  dateTime(""),
+			"points_issued":       integer("승인된 충전 합계"),
+			"points_adjusted":     integer("관리자 조정 합계"),
+			"total_spent":         integer(""),
+			"total_refunded":      integer(""),
+			"outstanding_balance": integer("모든 유저 잔액 합계"),
+			"top_booths":          array(ref("TopEntry")),
+			"top_products":        array(ref("TopEntry")),
+			"series": array(object(props{
+				"start":   dateTime(""),
+				"charged": integer(""),
+				"charges": integer(""),
+				"spent":   integer(""),
+				"orders":  integer(""),
+			})),
+		}),
+		"TopEntry": object(props{
+			"id":     integer(""),
+			"name":   str(""),
+			"sales":  integer(""),
+			"orders": integer(""),
+			"units":  integer(""),
+		}),
+		"AuditLog": object(props{
+			"id":             integer(""),
+			"entity_type":    enum("", auditEntityTypes...),
+			"entity_id":      integer(""),
+			"action":         enum("", auditActions...),
+			"actor_id":       integer("CLI 등 로그인하지 않은 변경이면 없다"),
+			"actor_username": str(""),
+			"ip":             str(""),
+			"changes": Schema{
+				"type":                 "object",
+				"description":          "필드 이름별 변경 전후 값. 생성이면 old, 삭제면 new 가 비어 있다.",
+				"additionalProperties": object(props{"old": {}, "new": {}}),
+			},
+			"timestamp": dateTime(""),
+		}),
+		"AuditLogPage": page("AuditLog"),
+	}
+}
@@ -0,0 +1,250 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"somapay-backend/ent"
+	"somapay-backend/ent/outboxevent"
+	"somapay-backend/ent/webhook"
+	"somapay-backend/ent/webhookdelivery"
+	"strconv"
+	"time"
+)
+
+const (
+	batchSize = 100
+
+	defaultMaxAttempts = 8
+	defaultBaseDelay   = 30 * time.Second
+	maxDelay           = time.Hour
+)
+
+// 수신 측에 보내는 헤더
+const (
+	HeaderEvent     = "X-Somapay-Event"
+	HeaderDelivery  = "X-Somapay-Delivery"
+	HeaderTimestamp = "X-Somapay-Timestamp"
+	HeaderSignature = "X-Somapay-Signature"
+)
+
+// Dispatcher 는 outbox 에 쌓인 이벤트를 등록된 웹훅으로 보낸다.
+// 실패한 전송은 지수적으로 늘어나는 간격으로 재시도하고, 한도를 넘기면 DEAD 로 남긴다.
+type Dispatcher struct {
+	client     *ent.Client
+	httpClient *http.Client
+
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+func NewDispatcher(client *ent.Client, httpClient *http.Client) *Dispatcher {
+	return &Dispatcher{
+		client:      client,
+		httpClient:  httpClient,
+		MaxAttempts: defaultMaxAttempts,
+		BaseDelay:   defaultBaseDelay,
+	}
+}
+
+// Run 은 ctx 가 끝날 때까지 interval 마다 RunOnce 를 호출한다.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("webhook dispatch failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 는 새 이벤트를 전송 건으로 펼치고, 보낼 때가 된 전송 건을 한 번씩 보낸다.
+func (d *Dispatcher) RunOnce(ctx context.Context) error {
+	if err := d.fanOut(ctx); err != nil {
+		return err
+	}
+	return d.deliverDue(ctx)
+}
+
+// fanOut 은 아직 펼치지 않은 이벤트마다 구독 중인 웹훅 수만큼 전송 건을 만든다.
+func (d *Dispatcher) fanOut(ctx context.Context) error {
+	evs, err := d.client.OutboxEvent.
+		Query().
+		Where(outboxevent.DispatchedEQ(false)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(batchSize).
+		All(ctx)
+	if err != nil || len(evs) == 0 {
+		return err
+	}
+
+	hooks, err := d.client.Webhook.
+		Query().
+		Where(webhook.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ev := range evs {
+		if err := d.fanOutEvent(ctx, ev, hooks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) fanOutEvent(ctx context.Context, ev *ent.OutboxEvent, hooks []*ent.Webhook) error {
+	tx, err := d.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, h := range hooks {
+		if len(h.Events) > 0 && !slices.Contains(h.Events, ev.Type) {
+			continue
+		}
+		err := tx.WebhookDelivery.
+			Create().
+			SetEventID(ev.ID).
+			SetWebhookID(h.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.OutboxEvent.UpdateOneID(ev.ID).SetDispatched(true).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *Dispatcher) deliverDue(ctx context.Context) error {
+	ds, err := d.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ("PENDING"),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		Limit(batchSize).
+		WithEvent().
+		WithWebhook().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, del := range ds {
+		if err := d.deliver(ctx, del); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver 는 전송 건 하나를 보내고 결과를 기록한다. 반환하는 에러는 DB 에러뿐이다.
+func (d *Dispatcher) deliver(ctx context.Context, del *ent.WebhookDelivery) error {
+	ev, h := del.Edges.Event, del.Edges.Webhook
+
+	body, err := json.Marshal(struct {
+		ID        int             `json:"id"`
+		Type      string          `json:"type"`
+		CreatedAt time.Time       `json:"created_at"`
+		Data      json.RawMessage `json:"data"`
+	}{ev.ID, ev.Type, ev.CreatedAt, json.RawMessage(ev.Payload)})
+	if err != nil {
+		return err
+	}
+
+	code, sendErr := d.send(ctx, h, del.ID, ev.Type, body)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	update := d.client.WebhookDelivery.
+		UpdateOneID(del.ID).
+		AddAttempts(1)
+	if code != 0 {
+		update.SetLastStatusCode(code)
+	}
+
+	if sendErr == nil {
+		return update.
+			SetStatus("DELIVERED").
+			SetDeliveredAt(time.Now()).
+			SetLastError("").
+			Exec(ctx)
+	}
+
+	update.SetLastError(sendErr.Error())
+
+	attempts := del.Attempts + 1
+	if attempts >= d.MaxAttempts {
+		update.SetStatus("DEAD")
+	} else {
+		update.SetNextAttemptAt(time.Now().Add(d.backoff(attempts)))
+	}
+	return update.Exec(ctx)
+}
+
+func (d *Dispatcher) send(ctx context.Context, h *ent.Webhook, deliveryID int, typ string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, typ)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(deliveryID))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(h.Secret, ts, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff 는 attempts 번 실패한 뒤 다음 시도까지 기다릴 시간이다.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.BaseDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// Sign 은 "타임스탬프.본문" 의 HMAC-SHA256 서명을 만든다.
+// 수신 측은 같은 방식으로 계산한 값과 X-Somapay-Signature 헤더를 비교하면 된다.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,283 @@
+package outbox_test
+
+import (
+	"bytes"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"somapay-backend/config"
+	"somapay-backend/ent"
+	"somapay-backend/ent/enttest"
+	"somapay-backend/handler"
+	"somapay-backend/outbox"
+	"somapay-backend/storage"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testSecret = "s3cret"
+
+// receiver 는 받은 요청을 기록하고 status 로 응답하는 웹훅 수신 서버다.
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, status int) (*receiver, *httptest.Server) {
+	t.Helper()
+
+	r := &receiver{status: status}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(srv.Close)
+	return r, srv
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func (r *receiver) first() (*http.Request, []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests[0], r.bodies[0]
+}
+
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, dialectName, err := storage.OpenDB(config.DatabaseConfig{
+		Driver: "sqlite",
+		DSN:    filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialectName, db))))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// setup 은 url 로 보내는 웹훅 하나와 아직 펼치지 않은 이벤트 하나를 만든다.
+func setup(t *testing.T, client *ent.Client, url string) {
+	t.Helper()
+
+	err := client.Webhook.Create().SetURL(url).SetSecret(testSecret).Exec(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := client.Tx(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := outbox.Record(t.Context(), tx, outbox.UserCreated, map[string]any{"id": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func onlyDelivery(t *testing.T, client *ent.Client) *ent.WebhookDelivery {
+	t.Helper()
+
+	d, err := client.WebhookDelivery.Query().Only(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// makeDue 는 백오프를 기다리지 않고 다음 RunOnce 에서 보내지게 한다.
+func makeDue(t *testing.T, client *ent.Client, d *ent.WebhookDelivery) {
+	t.Helper()
+
+	err := client.WebhookDelivery.UpdateOneID(d.ID).SetNextAttemptAt(time.Now().Add(-time.Second)).Exec(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDispatcherSignsRequests(t *testing.T) {
+	client := newTestClient(t)
+	recv, srv := newReceiver(t, http.StatusOK)
+	setup(t, client, srv.URL)
+
+	if err := outbox.NewDispatcher(client, srv.Client()).RunOnce(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	if recv.count() != 1 {
+		t.Fatalf("requests = %d, want 1", recv.count())
+	}
+	req, body := recv.first()
+
+	ts, err := strconv.ParseInt(req.Header.Get(outbox.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp header: %v", err)
+	}
+	if got, want := req.Header.Get(outbox.HeaderSignature), outbox.Sign(testSecret, ts, body); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+	if got := req.Header.Get(outbox.HeaderEvent); got != outbox.UserCreated {
+		t.Errorf("event header = %q", got)
+	}
+
+	d := onlyDelivery(t, client)
+	if got := req.Header.Get(outbox.HeaderDelivery); got != strconv.Itoa(d.ID) {
+		t.Errorf("delivery header = %q, want %d", got, d.ID)
+	}
+	if d.Status != "DELIVERED" || d.Attempts != 1 || d.DeliveredAt == nil {
+		t.Errorf("delivery = %+v, want DELIVERED after 1 attempt", d)
+	}
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	client := newTestClient(t)
+	recv, srv := newReceiver(t, http.StatusInternalServerError)
+	setup(t, client, srv.URL)
+
+	dispatcher := outbox.NewDispatcher(client, srv.Client())
+	dispatcher.BaseDelay = time.Minute
+
+	for attempt, delay := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		before := time.Now()
+		if err := dispatcher.RunOnce(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+
+		d := onlyDelivery(t, client)
+		if d.Status != "PENDING" || d.Attempts != attempt+1 {
+			t.Fatalf("attempt %d: delivery = %+v, want PENDING", attempt+1, d)
+		}
+		if d.LastStatusCode == nil || *d.LastStatusCode != http.StatusInternalServerError {
+			t.Errorf("attempt %d: last_status_code = %v, want 500", attempt+1, d.LastStatusCode)
+		}
+		if wait := d.NextAttemptAt.Sub(before); wait < delay-time.Second || wait > delay+time.Second {
+			t.Errorf("attempt %d: next attempt in %v, want about %v", attempt+1, wait, delay)
+		}
+
+		// 백오프가 끝나기 전에는 다시 보내지 않는다
+		if err := dispatcher.RunOnce(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		if recv.count() != attempt+1 {
+			t.Fatalf("attempt %d: requests = %d, want %d", attempt+1, recv.count(), attempt+1)
+		}
+
+		makeDue(t, client, d)
+	}
+}
+
+func TestDispatcherGivesUpAfterMaxAttempts(t *testing.T) {
+	client := newTestClient(t)
+	recv, srv := newReceiver(t, http.StatusInternalServerError)
+	setup(t, client, srv.URL)
+
+	dispatcher := outbox.NewDispatcher(client, srv.Client())
+	dispatcher.MaxAttempts = 3
+
+	for range dispatcher.MaxAttempts {
+		if err := dispatcher.RunOnce(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		makeDue(t, client, onlyDelivery(t, client))
+	}
+
+	d := onlyDelivery(t, client)
+	if d.Status != "DEAD" || d.Attempts != 3 {
+		t.Fatalf("delivery = %+v, want DEAD after 3 attempts", d)
+	}
+
+	if err := dispatcher.RunOnce(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if recv.count() != 3 {
+		t.Errorf("requests = %d, want 3", recv.count())
+	}
+}
+
+func TestRetryEndpointRequeuesDeadDelivery(t *testing.T) {
+	client := newTestClient(t)
+	recv, srv := newReceiver(t, http.StatusInternalServerError)
+	setup(t, client, srv.URL)
+
+	dispatcher := outbox.NewDispatcher(client, srv.Client())
+	dispatcher.MaxAttempts = 1
+	if err := dispatcher.RunOnce(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	d := onlyDelivery(t, client)
+	if d.Status != "DEAD" {
+		t.Fatalf("delivery = %+v, want DEAD", d)
+	}
+
+	admin, err := client.User.Create().SetUsername("admin").SetPassword("x").SetPin("1234").SetPoint(0).SetRole("ADMIN").Save(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := fiber.New(fiber.Config{ErrorHandler: handler.ErrorHandler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", admin)
+		return c.Next()
+	})
+	app.Post("/webhooks/deliveries/:id/retry", handler.RetryWebhookDeliveryHandler(client))
+
+	path := "/webhooks/deliveries/" + strconv.Itoa(d.ID) + "/retry"
+	res, err := app.Test(httptest.NewRequest("POST", path, bytes.NewReader(nil)), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("retry status = %d", res.StatusCode)
+	}
+
+	d = onlyDelivery(t, client)
+	if d.Status != "PENDING" || d.Attempts != 0 {
+		t.Fatalf("delivery = %+v, want PENDING with 0 attempts", d)
+	}
+
+	// 이미 대기 중인 전송은 다시 넣을 수 없다
+	res, err = app.Test(httptest.NewRequest("POST", path, bytes.NewReader(nil)), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusConflict {
+		t.Errorf("second retry status = %d, want 409", res.StatusCode)
+	}
+
+	recv.setStatus(http.StatusOK)
+	if err := dispatcher.RunOnce(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	d = onlyDelivery(t, client)
+	if d.Status != "DELIVERED" || recv.count() != 2 {
+		t.Errorf("delivery = %+v after %d requests, want DELIVERED after 2", d, recv.count())
+	}
+}
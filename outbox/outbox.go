@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"somapay-backend/ent"
+)
+
+// 외부로 내보내는 이벤트 종류
+const (
+	TransactionCreated   = "transaction.created"
+	TransactionRefunded  = "transaction.refunded"
+	ChargeRequestDecided = "charge_request.decided"
+	UserCreated          = "user.created"
+)
+
+var EventTypes = map[string]bool{
+	TransactionCreated:   true,
+	TransactionRefunded:  true,
+	ChargeRequestDecided: true,
+	UserCreated:          true,
+}
+
+// Record 는 이벤트를 tx 안에서 outbox 에 기록한다.
+// 도메인 변경과 함께 커밋되므로 롤백된 변경이 밖으로 나가거나 커밋된 변경이 빠지는 일이 없다.
+func Record(ctx context.Context, tx *ent.Tx, typ string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return tx.OutboxEvent.
+		Create().
+		SetType(typ).
+		SetPayload(string(payload)).
+		Exec(ctx)
+}
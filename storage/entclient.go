@@ -2,30 +2,147 @@ package storage
 
 import (
 	"context"
+	"database/sql"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"errors"
+	"fmt"
 	"log"
+	"net/url"
+	"somapay-backend/config"
 	"somapay-backend/ent"
+	"somapay-backend/migrations"
+	"strings"
 	"sync"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
 )
 
 var (
-	client *ent.Client
-	once   sync.Once
+	client   *ent.Client
+	db       *sql.DB
+	migrator *migrations.Migrator
+	once     sync.Once
 )
 
-func GetClient() *ent.Client {
+func GetClient(cfg config.DatabaseConfig) *ent.Client {
 	once.Do(func() {
-		var err error
-		client, err = ent.Open("mysql", "root:1234@tcp(localhost:3306)/somapay?parseTime=True")
+		var (
+			dialectName string
+			err         error
+		)
+		db, dialectName, err = OpenDB(cfg)
 		if err != nil {
-			log.Fatalf("failed opening connection to mysql: %v", err)
+			log.Fatalf("failed opening connection to %s: %v", cfg.Driver, err)
 		}
 
-		if err := client.Schema.Create(context.Background()); err != nil {
-			log.Fatalf("failed creating schema resources: %v", err)
+		// 스키마는 migrate 명령으로만 바꾼다. DB 가 이 빌드와 맞지 않으면 시작하지 않는다.
+		migrator, err = migrations.New(db, cfg.Driver)
+		if err != nil {
+			log.Fatalf("failed loading migrations: %v", err)
+		}
+		if err := migrator.Check(context.Background()); err != nil {
+			log.Fatalf("database schema is not up to date: %v", err)
 		}
+
+		client = ent.NewClient(ent.Driver(entsql.OpenDB(dialectName, db)))
 	})
 
 	return client
 }
+
+// DB 는 GetClient 가 연 커넥션 풀이다.
+func DB() *sql.DB {
+	return db
+}
+
+// Ready 는 DB 에 연결할 수 있고 스키마가 이 빌드와 맞는지 확인한다.
+// /readyz 마다 불리므로 버전을 읽기만 하고 버전 테이블을 만들지 않는다.
+func Ready(ctx context.Context) error {
+	if db == nil {
+		return errors.New("database is not opened")
+	}
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	return migrator.Check(ctx)
+}
+
+// OpenDB 는 설정된 드라이버(mysql, postgres, sqlite)로 DB 에 연결하고 ent 방언 이름을 함께 돌려준다.
+func OpenDB(cfg config.DatabaseConfig) (*sql.DB, string, error) {
+	var driverName, dialectName string
+	dsn := cfg.DSN
+
+	switch cfg.Driver {
+	case "mysql":
+		driverName, dialectName = "mysql", dialect.MySQL
+	case "postgres":
+		driverName, dialectName = "pgx", dialect.Postgres
+	case "sqlite":
+		driverName, dialectName = "sqlite", dialect.SQLite
+		dsn = sqliteDSN(dsn)
+	default:
+		return nil, "", fmt.Errorf("unsupported database driver %q", cfg.Driver)
+	}
+
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 메모리 DB 는 연결마다 따로 생기므로 연결을 하나로 묶는다
+	if cfg.Driver == "sqlite" && strings.Contains(dsn, ":memory:") {
+		db.SetMaxOpenConns(1)
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, "", err
+	}
+
+	return db, dialectName, nil
+}
+
+// sqliteDSN 은 DSN 에 빠진 SQLite 설정을 채운다.
+// 외래 키를 켜고, 시간 형식을 맞추고, 쓰기 트랜잭션이 시작할 때 바로 잠금을 잡아(_txlock=immediate)
+// 동시에 결제가 들어와도 읽은 뒤 쓰기로 올라가다 서로 막히는 일 없이 차례로 처리되게 한다.
+func sqliteDSN(dsn string) string {
+	path, rawQuery, _ := strings.Cut(dsn, "?")
+	q, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return dsn
+	}
+
+	pragmas := []struct{ name, value string }{
+		{"foreign_keys", "foreign_keys(1)"},
+		{"busy_timeout", "busy_timeout(5000)"},
+	}
+	if !strings.Contains(path, ":memory:") {
+		pragmas = append(pragmas, struct{ name, value string }{"journal_mode", "journal_mode(WAL)"})
+	}
+	for _, p := range pragmas {
+		if !hasPragma(q, p.name) {
+			q.Add("_pragma", p.value)
+		}
+	}
+	if q.Get("_txlock") == "" {
+		q.Set("_txlock", "immediate")
+	}
+	// 시간 값을 SQLite 날짜 함수가 읽을 수 있는 형식으로 저장한다
+	if q.Get("_time_format") == "" {
+		q.Set("_time_format", "sqlite")
+	}
+
+	return path + "?" + q.Encode()
+}
+
+func hasPragma(q url.Values, name string) bool {
+	for _, v := range q["_pragma"] {
+		if strings.HasPrefix(strings.ToLower(v), name) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,106 @@
 package storage
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
+type Session struct {
+	UserID    int
+	CreatedAt time.Time
+	LastSeen  time.Time
+}
+
+// SessionStore 는 로그인 토큰을 메모리에 보관한다.
+// IdleTimeout 동안 쓰이지 않았거나 MaxLifetime 이 지난 세션은 만료된다. 0 이면 제한하지 않는다.
 type SessionStore struct {
 	sync.RWMutex
-	Data map[string]int
+	Data map[string]*Session
+
+	IdleTimeout time.Duration
+	MaxLifetime time.Duration
 }
 
-func GetSessionStore() *SessionStore {
-	return &SessionStore{Data: make(map[string]int)}
+func GetSessionStore(idleTimeout, maxLifetime time.Duration) *SessionStore {
+	return &SessionStore{
+		Data:        make(map[string]*Session),
+		IdleTimeout: idleTimeout,
+		MaxLifetime: maxLifetime,
+	}
+}
+
+func (s *SessionStore) Add(token string, userID int) {
+	now := time.Now()
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.Data[token] = &Session{UserID: userID, CreatedAt: now, LastSeen: now}
+}
+
+// Lookup 은 토큰의 세션을 돌려주고 마지막 사용 시각을 갱신한다. 만료된 세션은 지운다.
+func (s *SessionStore) Lookup(token string) (Session, bool) {
+	now := time.Now()
+
+	s.Lock()
+	defer s.Unlock()
+
+	sess, ok := s.Data[token]
+	if !ok {
+		return Session{}, false
+	}
+	if s.expired(sess, now) {
+		delete(s.Data, token)
+		return Session{}, false
+	}
+
+	sess.LastSeen = now
+	return *sess, true
+}
+
+func (s *SessionStore) Remove(token string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.Data, token)
+}
+
+// Count 는 만료되지 않은 세션 수를 센다.
+func (s *SessionStore) Count() int {
+	now := time.Now()
+
+	s.RLock()
+	defer s.RUnlock()
+
+	n := 0
+	for _, sess := range s.Data {
+		if !s.expired(sess, now) {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *SessionStore) expired(sess *Session, now time.Time) bool {
+	if s.IdleTimeout > 0 && now.Sub(sess.LastSeen) > s.IdleTimeout {
+		return true
+	}
+	if s.MaxLifetime > 0 && now.Sub(sess.CreatedAt) > s.MaxLifetime {
+		return true
+	}
+	return false
+}
+
+// Sweep 은 만료된 세션을 한꺼번에 지운다.
+func (s *SessionStore) Sweep() {
+	now := time.Now()
+
+	s.Lock()
+	defer s.Unlock()
+
+	for token, sess := range s.Data {
+		if s.expired(sess, now) {
+			delete(s.Data, token)
+		}
+	}
 }
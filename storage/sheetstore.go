@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// CredentialSheet 은 명단으로 만든 계정의 초기 비밀번호 목록이다.
+type CredentialSheet struct {
+	OwnerID   int
+	CreatedAt time.Time
+	Rows      []CredentialRow
+}
+
+type CredentialRow struct {
+	Username string
+	Name     string
+	Grade    int
+	Class    int
+	Role     string
+	Password string
+	Pin      string
+}
+
+// SheetStore 는 평문 비밀번호가 담긴 계정표를 DB 에 남기지 않도록 메모리에만 잠시 보관한다.
+// 한 번 꺼내면 지워지고, TTL 이 지나도 지워진다.
+type SheetStore struct {
+	sync.Mutex
+	data map[string]*CredentialSheet
+
+	TTL time.Duration
+}
+
+func NewSheetStore(ttl time.Duration) *SheetStore {
+	return &SheetStore{
+		data: make(map[string]*CredentialSheet),
+		TTL:  ttl,
+	}
+}
+
+func (s *SheetStore) Put(sheet *CredentialSheet) string {
+	id := uuid.New().String()
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.data[id] = sheet
+	return id
+}
+
+// Take 는 ownerID 가 만든 계정표를 꺼내고 지운다. 다른 사람의 요청이면 지우지 않는다.
+func (s *SheetStore) Take(id string, ownerID int) (*CredentialSheet, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	sheet, ok := s.data[id]
+	if !ok || sheet.OwnerID != ownerID {
+		return nil, false
+	}
+	delete(s.data, id)
+
+	if s.expired(sheet, time.Now()) {
+		return nil, false
+	}
+	return sheet, true
+}
+
+func (s *SheetStore) ExpiresAt(sheet *CredentialSheet) time.Time {
+	return sheet.CreatedAt.Add(s.TTL)
+}
+
+func (s *SheetStore) expired(sheet *CredentialSheet, now time.Time) bool {
+	return s.TTL > 0 && now.After(s.ExpiresAt(sheet))
+}
+
+// Sweep 은 내려받지 않고 만료된 계정표를 지운다.
+func (s *SheetStore) Sweep() {
+	now := time.Now()
+
+	s.Lock()
+	defer s.Unlock()
+
+	for id, sheet := range s.data {
+		if s.expired(sheet, now) {
+			delete(s.data, id)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+#!/bin/sh
+# 테스트용 계정 생성. 비밀번호는 해시되어 저장되므로 로그인할 수 있다.
+set -e
+
+go run . user create user -role USER -password user -pin 0000
+go run . user create admin -role ADMIN -password admin -pin 0000
+go run . user create host -role HOST -password host -pin 0000
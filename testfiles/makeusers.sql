@@ -1,8 +0,0 @@
-INSERT INTO users (username, password, point, pin, role)
-VALUES ('user', 'user', 0, '0000', 'USER');
-
-INSERT INTO users (username, password, point, pin, role)
-VALUES ('admin', 'admin', 0, '0000', 'ADMIN');
-
-INSERT INTO users (username, password, point, pin, role)
-VALUES ('host', 'host', 0, '0000', 'HOST');
\ No newline at end of file